@@ -0,0 +1,230 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// alignmentGaps lists the characters that mark a gap in an alignment row
+const alignmentGaps = "-."
+
+// clustalHeaders lists the first words of the header line of Clustal-format alignments
+var clustalHeaders = []string{"CLUSTAL", "MUSCLE", "PROBCONS", "MSAPROBS"}
+
+// ReadAlignment()
+// Input: an io.Reader with a multiple sequence alignment in aligned FASTA or Clustal format
+// Output: the rows of the alignment in file order, upper-cased, with '-' or '.' for gaps, or an error if the format
+// is not recognised, the rows have different lengths, a row has characters other than letters and gaps, or the
+// first row (the query) has no residues
+func ReadAlignment(r io.Reader) ([]FASTARecord, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read alignment: %v", err)
+	}
+	text := strings.TrimPrefix(string(content), "\ufeff")
+
+	var rows []FASTARecord
+	first := strings.TrimSpace(text)
+	switch {
+	case strings.HasPrefix(first, ">"):
+		if rows, err = ReadFASTA(strings.NewReader(text)); err != nil {
+			return nil, err
+		}
+	case isClustalHeader(first):
+		if rows, err = parseClustal(text); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unrecognised alignment format: expected aligned FASTA or Clustal")
+	}
+
+	width := len(rows[0].Sequence)
+	for i := range rows {
+		rows[i].Sequence = strings.ToUpper(rows[i].Sequence)
+		if len(rows[i].Sequence) != width {
+			return nil, fmt.Errorf("row %s has %d columns but the first row has %d", rows[i].ID, len(rows[i].Sequence), width)
+		}
+		for j, c := range rows[i].Sequence {
+			if (c < 'A' || c > 'Z') && !strings.ContainsRune(alignmentGaps, c) {
+				return nil, fmt.Errorf("row %s: invalid character %q at column %d", rows[i].ID, c, j+1)
+			}
+		}
+	}
+	if ungapped(rows[0].Sequence) == "" {
+		return nil, fmt.Errorf("the first row (%s) has no residues", rows[0].ID)
+	}
+	return rows, nil
+}
+
+// ReadAlignmentFile()
+// Input: the path of an alignment file, or "-" to read from standard input
+// Output: the rows of the alignment (see ReadAlignment), or an error naming the file
+func ReadAlignmentFile(filename string) ([]FASTARecord, error) {
+	if filename == "-" {
+		return ReadAlignment(os.Stdin)
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	rows, err := ReadAlignment(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return rows, nil
+}
+
+// isClustalHeader reports whether a line is the header line of a Clustal-format alignment
+func isClustalHeader(line string) bool {
+	for _, header := range clustalHeaders {
+		if strings.HasPrefix(line, header) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseClustal reads the blocks of a Clustal-format alignment: after the header line, each line holds a row name and
+// a segment of the row, optionally followed by a residue count; lines starting with whitespace mark conservation
+func parseClustal(text string) ([]FASTARecord, error) {
+	var rows []FASTARecord
+	index := make(map[string]int) // Row of each name
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	lineNum := 0
+	header := true
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		if header {
+			if strings.TrimSpace(line) != "" {
+				header = false // Skip the CLUSTAL line
+			}
+			continue
+		}
+		if strings.TrimSpace(line) == "" || line[0] == ' ' || line[0] == '\t' {
+			continue // Blank and conservation lines
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, fmt.Errorf("line %d: expected a row name and its aligned residues", lineNum)
+		}
+		if len(fields) == 3 {
+			if _, err := strconv.Atoi(fields[2]); err != nil {
+				return nil, fmt.Errorf("line %d: expected a residue count after the aligned residues, got %q", lineNum, fields[2])
+			}
+		}
+		name := fields[0]
+		i, ok := index[name]
+		if !ok {
+			i = len(rows)
+			index[name] = i
+			rows = append(rows, FASTARecord{ID: name})
+		}
+		rows[i].Sequence += fields[1]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read Clustal alignment: %v", err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no alignment rows found")
+	}
+	return rows, nil
+}
+
+// ungapped returns an alignment row with its gaps removed
+func ungapped(row string) string {
+	return strings.Map(func(c rune) rune {
+		if strings.ContainsRune(alignmentGaps, c) {
+			return -1
+		}
+		return c
+	}, row)
+}
+
+/*
+	GORAlignmentPredict predicts GOR secondary structure for the first row of an alignment from the scores of every
+	row, as in GOR V: each row is scored on its own, without gaps, and the scores of the query residue in each column
+	are averaged over the rows that have a residue in that column.
+
+Input: the rows of an alignment (the first row is the query) and the function scoring one gap-free sequence (GOR I
+or GOR III with the predictor's tables).
+Output: one GORPredictionResult per residue of the query, with the averaged scores and the structure with the
+highest average, or an error naming the row that could not be scored.
+*/
+func GORAlignmentPredict(alignment []FASTARecord, predict func(sequence string) ([]GORPredictionResult, error)) ([]GORPredictionResult, error) {
+	if len(alignment) == 0 {
+		return nil, fmt.Errorf("empty alignment")
+	}
+	query := alignment[0].Sequence
+
+	var sums [][4]float64 // Summed scores of each query residue
+	var counts []int      // Rows with a residue in the column of each query residue
+	for _, c := range query {
+		if !strings.ContainsRune(alignmentGaps, c) {
+			sums = append(sums, [4]float64{})
+			counts = append(counts, 0)
+		}
+	}
+
+	for _, row := range alignment {
+		sequence := strings.ToUpper(row.Sequence)
+		if len(sequence) != len(query) {
+			return nil, fmt.Errorf("row %s has %d columns but the query has %d", row.ID, len(sequence), len(query))
+		}
+		predictions, err := predict(ungapped(sequence))
+		if err != nil {
+			return nil, fmt.Errorf("row %s: %v", row.ID, err)
+		}
+
+		// Walk the columns, tracking the residue index in the query and in this row
+		q, r := 0, 0
+		for col := 0; col < len(query); col++ {
+			queryGap := strings.IndexByte(alignmentGaps, query[col]) >= 0
+			rowGap := strings.IndexByte(alignmentGaps, sequence[col]) >= 0
+			if !queryGap && !rowGap {
+				scores := gorScores(predictions[r])
+				for k := range scores {
+					sums[q][k] += scores[k]
+				}
+				counts[q]++
+			}
+			if !queryGap {
+				q++
+			}
+			if !rowGap {
+				r++
+			}
+		}
+	}
+
+	residues := ungapped(strings.ToUpper(query))
+	results := make([]GORPredictionResult, len(sums))
+	for i := range sums {
+		for k := range sums[i] {
+			sums[i][k] /= float64(counts[i]) // The query itself always counts
+		}
+		results[i] = GORPredictionResult{
+			Position:           i + 1,
+			Residue:            string(residues[i]),
+			ScoreAlpha:         sums[i][0],
+			ScoreBeta:          sums[i][1],
+			ScoreTurn:          sums[i][2],
+			ScoreCoil:          sums[i][3],
+			PredictedStructure: gorStructure(sums[i][0], sums[i][1], sums[i][2], sums[i][3]),
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,146 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadAlignment(t *testing.T) {
+	fasta := ">query first row\nMKT-AY\n>hom1\nmkt-ay\n>hom2\nMR..AF\n"
+	clustal := "CLUSTAL W (1.83) multiple sequence alignment\n\n" +
+		"query      MKT-\nhom1       mkt- 3\nhom2       MR..\n           *: \n\n" +
+		"query      AY\nhom1       ay 5\nhom2       AF\n           *.\n"
+	expected := []FASTARecord{
+		{ID: "query", Sequence: "MKT-AY"},
+		{ID: "hom1", Sequence: "MKT-AY"},
+		{ID: "hom2", Sequence: "MR..AF"},
+	}
+
+	rows, err := ReadAlignment(strings.NewReader(fasta))
+	if err != nil {
+		t.Fatalf("ReadAlignment returned error for aligned FASTA: %v", err)
+	}
+	expected[0].Description = "first row"
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("Aligned FASTA: expected %+v, got %+v", expected, rows)
+	}
+
+	rows, err = ReadAlignment(strings.NewReader(clustal))
+	if err != nil {
+		t.Fatalf("ReadAlignment returned error for Clustal: %v", err)
+	}
+	expected[0].Description = ""
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("Clustal: expected %+v, got %+v", expected, rows)
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		message string
+	}{
+		{"unknown format", "MKTAY\n", "unrecognised alignment format"},
+		{"ragged rows", ">a\nMKT\n>b\nMK\n", "row b has 2 columns but the first row has 3"},
+		{"invalid character", ">a\nMKT\n>b\nM*T\n", "row b: invalid character '*' at column 2"},
+		{"gap-only query", ">a\n---\n>b\nMKT\n", "the first row (a) has no residues"},
+		{"clustal without rows", "CLUSTAL W\n\n", "no alignment rows found"},
+		{"clustal with spaces", "CLUSTAL W\n\nquery MKT AY\n", `line 3: expected a residue count after the aligned residues, got "AY"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ReadAlignment(strings.NewReader(test.content))
+			if err == nil || !strings.Contains(err.Error(), test.message) {
+				t.Errorf("Expected error containing %q, got %v", test.message, err)
+			}
+		})
+	}
+}
+
+func TestGORAlignmentPredict(t *testing.T) {
+	// Each residue scores its index in its gap-free row (alpha) and the length of the row (coil)
+	predict := func(sequence string) ([]GORPredictionResult, error) {
+		predictions := make([]GORPredictionResult, len(sequence))
+		for i := range sequence {
+			predictions[i] = GORPredictionResult{ScoreAlpha: float64(i), ScoreCoil: float64(len(sequence))}
+		}
+		return predictions, nil
+	}
+	alignment := []FASTARecord{
+		{ID: "query", Sequence: "AC-DE"},
+		{ID: "hom1", Sequence: "MAGDE"},
+		{ID: "hom2", Sequence: "--.DE"},
+	}
+
+	predictions, err := GORAlignmentPredict(alignment, predict)
+	if err != nil {
+		t.Fatalf("GORAlignmentPredict returned error: %v", err)
+	}
+	expectedAlpha := []float64{0, 1, 5.0 / 3, 8.0 / 3}
+	expectedCoil := []float64{4.5, 4.5, 11.0 / 3, 11.0 / 3}
+	if len(predictions) != 4 || OutputGORSequence(predictions) == "" {
+		t.Fatalf("Expected one prediction per query residue, got %+v", predictions)
+	}
+	for i, p := range predictions {
+		if p.Position != i+1 || p.Residue != string("ACDE"[i]) {
+			t.Errorf("Residue %d: expected %c at position %d, got %s at %d", i, "ACDE"[i], i+1, p.Residue, p.Position)
+		}
+		if !floatEquals(p.ScoreAlpha, expectedAlpha[i], 1e-9) || !floatEquals(p.ScoreCoil, expectedCoil[i], 1e-9) {
+			t.Errorf("Residue %d: expected alpha %v and coil %v, got %v and %v", i+1, expectedAlpha[i], expectedCoil[i], p.ScoreAlpha, p.ScoreCoil)
+		}
+	}
+
+	// An alignment of the query alone reproduces single-sequence GOR
+	predictor, err := NewPredictor("", "")
+	if err != nil {
+		t.Fatalf("NewPredictor returned error: %v", err)
+	}
+	sequence := "MKTAYIAKQRQISFVKSHFSRQLEERLGLIEVQ"
+	single, _ := predictor.predictGOR(sequence)
+	averaged, err := GORAlignmentPredict([]FASTARecord{{ID: "q", Sequence: sequence}, {ID: "copy", Sequence: sequence}}, predictor.predictGOR)
+	if err != nil {
+		t.Fatalf("GORAlignmentPredict returned error: %v", err)
+	}
+	for i := range single {
+		if !floatEquals(single[i].ScoreAlpha, averaged[i].ScoreAlpha, 1e-9) || single[i].PredictedStructure != averaged[i].PredictedStructure {
+			t.Errorf("Residue %d: averaging identical rows changed the prediction", i+1)
+		}
+	}
+}
+
+func TestPredictAlignmentCommand(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "family.aln")
+	content := "CLUSTAL O(1.2.4) multiple sequence alignment\n\n" +
+		"query      MKTAYIAKQRQISFVKSHFSRQ-LEERLGLIEVQ\n" +
+		"hom1       MKSAYIAKQRQLSFVKAHFSRQDLEERLGLIEVQ\n" +
+		"hom2       --TAYLAKQRQISWVKSHF----LEEKLGLVEV-\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := RunCLI([]string{"predict", "-msa", "-format", "json", path}, &out); err != nil {
+		t.Fatalf("predict -msa returned error: %v", err)
+	}
+	var results []PredictionResult
+	if err := json.Unmarshal(out.Bytes(), &results); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+	if len(results) != 1 || results[0].ID != "query" || results[0].AlignmentRows != 3 {
+		t.Fatalf("Expected one result for the query averaged over 3 rows, got %+v", results)
+	}
+	if results[0].Sequence != "MKTAYIAKQRQISFVKSHFSRQLEERLGLIEVQ" || len(results[0].GOR) != len(results[0].Sequence) {
+		t.Errorf("Expected a prediction for the gap-free query, got %s for %s", results[0].GOR, results[0].Sequence)
+	}
+
+	if err := RunCLI([]string{"predict", "-msa", "MKTAY"}, &out); err == nil {
+		t.Errorf("Expected an error for a missing alignment file")
+	}
+}
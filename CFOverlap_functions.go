@@ -0,0 +1,126 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Chou-Fasman overlap modes: how residues predicted as more than one structure are resolved
+const (
+	CFOverlapScore   = "score"   // ClassifyOverlap: the region with the higher whole-region score wins (the original behaviour)
+	CFOverlapAverage = "average" // ResolveOverlapAverage: average propensities over the overlapping residues, as published
+)
+
+// CFOverlapModes lists the overlap modes accepted by ParseCFOverlapMode
+var CFOverlapModes = []string{CFOverlapScore, CFOverlapAverage}
+
+// ParseCFOverlapMode()
+// Input: the name of an overlap mode ("" for the default)
+// Output: the overlap mode, or an error if the name is not one of CFOverlapModes
+func ParseCFOverlapMode(name string) (string, error) {
+	if name == "" {
+		return CFOverlapScore, nil
+	}
+	for _, mode := range CFOverlapModes {
+		if name == mode {
+			return mode, nil
+		}
+	}
+	return "", fmt.Errorf("unknown Chou-Fasman overlap mode %q: expected %s", name, strings.Join(CFOverlapModes, ", "))
+}
+
+// SetCFOverlap()
+// Input: an overlap mode (see CFOverlapModes)
+// Output: an error if the mode is unknown; on success Predict resolves Chou-Fasman overlaps with it
+func (p *Predictor) SetCFOverlap(name string) error {
+	mode, err := ParseCFOverlapMode(name)
+	if err != nil {
+		return err
+	}
+	p.CFOverlap = mode
+	return nil
+}
+
+// resolveOverlaps assigns the predicted regions to result with the given overlap mode
+func (cf CFParameters) resolveOverlaps(sequence []rune, helixRegions, sheetRegions, turnRegions []Region, result []rune, overlap string) {
+	if overlap == CFOverlapAverage {
+		cf.ResolveOverlapAverage(sequence, helixRegions, sheetRegions, turnRegions, result)
+		return
+	}
+	ClassifyOverlap(sequence, helixRegions, sheetRegions, turnRegions, result)
+}
+
+/*
+	ResolveOverlapAverage assigns helix, sheet and turn regions to the result as in the published Chou-Fasman
+	method: where regions of different structures overlap, the average propensities are computed over the overlapping
+	residues only. A turn wins when its average Pt exceeds both the average Pa and the average Pb; otherwise a helix
+	and a sheet are decided by the higher of the average Pa and Pb.
+
+Input: the sequence as a slice of runes, the helix, sheet and turn Regions, and the result slice (changed in place).
+Output: none; residues covered by one structure get it, and residues outside every region are left unchanged.
+
+The residues are split into runs covered by the same set of structures, and each run is decided on its own, so the
+result does not depend on the order of the regions (ClassifyOverlap applies pairwise overwrites in slice order).
+*/
+func (cf CFParameters) ResolveOverlapAverage(sequence []rune, helixRegions, sheetRegions, turnRegions []Region, result []rune) {
+	// Mark the structures (H, E, T) that cover each residue
+	covered := make([][3]bool, len(result))
+	for k, regions := range [][]Region{helixRegions, sheetRegions, turnRegions} {
+		for _, region := range regions {
+			for i := Max(region.start, 0); i < Min(region.end, len(result)); i++ {
+				covered[i][k] = true
+			}
+		}
+	}
+
+	for start := 0; start < len(result); {
+		end := start + 1
+		for end < len(result) && covered[end] == covered[start] {
+			end++
+		}
+		if structure, ok := cf.resolveOverlapRun(sequence[start:end], covered[start]); ok {
+			for i := start; i < end; i++ {
+				result[i] = structure
+			}
+		}
+		start = end
+	}
+}
+
+// resolveOverlapRun returns the structure of a run of residues covered by the given structures (H, E, T), and false
+// if none covers it
+func (cf CFParameters) resolveOverlapRun(window []rune, covered [3]bool) (rune, bool) {
+	helix, sheet, turn := covered[0], covered[1], covered[2]
+	switch {
+	case !helix && !sheet && !turn:
+		return 'C', false
+	case helix && !sheet && !turn:
+		return 'H', true
+	case sheet && !helix && !turn:
+		return 'E', true
+	case turn && !helix && !sheet:
+		return 'T', true
+	}
+
+	pa := cf.CalculateAveragePropensity(window, 'H')
+	pb := cf.CalculateAveragePropensity(window, 'E')
+	pt := cf.CalculateAveragePropensity(window, 'T')
+	if turn && pt > pa && pt > pb {
+		return 'T', true
+	}
+	switch {
+	case helix && sheet:
+		if pb > pa {
+			return 'E', true
+		}
+		return 'H', true
+	case helix:
+		return 'H', true
+	default:
+		return 'E', true
+	}
+}
@@ -0,0 +1,119 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestParseCFOverlapMode(t *testing.T) {
+	for name, expected := range map[string]string{"": CFOverlapScore, "score": CFOverlapScore, "average": CFOverlapAverage} {
+		if mode, err := ParseCFOverlapMode(name); err != nil || mode != expected {
+			t.Errorf("ParseCFOverlapMode(%q) = %q, %v; expected %q", name, mode, err, expected)
+		}
+	}
+	if _, err := ParseCFOverlapMode("first"); err == nil || !strings.Contains(err.Error(), "expected score, average") {
+		t.Errorf("Expected an error for an unknown mode, got %v", err)
+	}
+}
+
+func TestResolveOverlapAverage(t *testing.T) {
+	cf := DefaultCFParameters()
+
+	tests := []struct {
+		name     string
+		sequence string
+		helix    []Region
+		sheet    []Region
+		turn     []Region
+		expected string
+	}{
+		{
+			// The overlap VVVV favours sheet although the helix region has the higher score
+			name:     "helix and sheet",
+			sequence: "EEEEVVVVVVVVAA",
+			helix:    []Region{{0, 8, 1.5, 'H'}},
+			sheet:    []Region{{4, 12, 1.2, 'E'}},
+			expected: "HHHHEEEEEEEECC",
+		},
+		{
+			// EEEE favours helix although the sheet region has the higher score
+			name:     "helix wins its overlap",
+			sequence: "VVVVEEEEEEEEAA",
+			helix:    []Region{{0, 8, 1.1, 'H'}},
+			sheet:    []Region{{2, 6, 1.4, 'E'}},
+			expected: "HHHHHHHHCCCCCC",
+		},
+		{
+			// NGPD has a higher average Pt than Pa and Pb, so the turn wins inside the helix
+			name:     "turn beats helix",
+			sequence: "AAAANGPDAAAA",
+			helix:    []Region{{0, 12, 1.2, 'H'}},
+			turn:     []Region{{4, 8, 1.5, 'T'}},
+			expected: "HHHHTTTTHHHH",
+		},
+		{
+			// Pt of ALEM is below Pa, so the helix keeps the residues
+			name:     "helix beats turn",
+			sequence: "AAAAALEMAAAA",
+			helix:    []Region{{0, 12, 1.2, 'H'}},
+			turn:     []Region{{4, 8, 1.0, 'T'}},
+			expected: "HHHHHHHHHHHH",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sequence := []rune(test.sequence)
+			result := []rune(strings.Repeat("C", len(sequence))) // Residues outside every region stay C
+			cf.ResolveOverlapAverage(sequence, test.helix, test.sheet, test.turn, result)
+			if string(result) != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestResolveOverlapAverageOrder(t *testing.T) {
+	cf := DefaultCFParameters()
+	sequence := []rune("MKTAYIAKQRQISFVKSHFSRQLEERLGLIEVQGNPDGSMEELAKKALELAKKG")
+	helix, sheet, turn := cf.PredictHelix(sequence), cf.PredictSheet(sequence), cf.PredictTurn(sequence)
+	// Extra regions so that several regions of each structure overlap
+	helix = append(helix, Region{10, 30, 1.1, 'H'})
+	sheet = append(sheet, Region{5, 25, 1.3, 'E'}, Region{20, 40, 1.0, 'E'})
+
+	expected := make([]rune, len(sequence))
+	cf.ResolveOverlapAverage(sequence, helix, sheet, turn, expected)
+	reverse := func(regions []Region) []Region {
+		reversed := make([]Region, len(regions))
+		for i, region := range regions {
+			reversed[len(regions)-1-i] = region
+		}
+		return reversed
+	}
+	result := make([]rune, len(sequence))
+	cf.ResolveOverlapAverage(sequence, reverse(helix), reverse(sheet), reverse(turn), result)
+	if string(result) != string(expected) {
+		t.Errorf("Expected the same result for reversed regions:\n%s\n%s", string(expected), string(result))
+	}
+}
+
+func TestCFOverlapFlag(t *testing.T) {
+	p, err := predictorFromFlags(t, "-cf-overlap", "average")
+	if err != nil || p.CFOverlap != CFOverlapAverage {
+		t.Errorf("Expected the average overlap mode to be selected, got %q (%v)", p.CFOverlap, err)
+	}
+	if p, err = predictorFromFlags(t); err != nil || p.CFOverlap != CFOverlapScore {
+		t.Errorf("Expected the score mode without -cf-overlap, got %q (%v)", p.CFOverlap, err)
+	}
+
+	var out bytes.Buffer
+	if err := RunCLI([]string{"predict", "-cf-overlap", "average", "MKTAYIAKQRQISFVKSHFSRQLEERLGLIEVQ"}, &out); err != nil {
+		t.Errorf("predict -cf-overlap average returned error: %v", err)
+	}
+	if err := RunCLI([]string{"predict", "-cf-overlap", "first", "MKTAYIAKQ"}, &out); err == nil || !strings.Contains(err.Error(), "overlap mode") {
+		t.Errorf("Expected an error for an unknown overlap mode, got %v", err)
+	}
+}
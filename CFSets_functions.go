@@ -0,0 +1,66 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultCFParameterSet is the name of the Chou-Fasman values the predictor has always used
+const DefaultCFParameterSet = "original"
+
+// CFParameterSets lists the Chou-Fasman parameter sets built into the binary. Published tables are only added here
+// once every value has been checked against the table of the paper cited in Reference; others can be loaded from a
+// file with LoadCFParameters.
+var CFParameterSets = []CFParameterSet{
+	{
+		Name:         DefaultCFParameterSet,
+		Reference:    "The values this program has always used (propensities and bendProbabilitiesTable in datatypes.go)",
+		Propensities: propensities,
+		Bends:        bendProbabilitiesTable,
+	},
+}
+
+// Parameters returns the propensities and bend probabilities of the set
+func (set CFParameterSet) Parameters() CFParameters {
+	return CFParameters{Propensities: set.Propensities, Bends: set.Bends}
+}
+
+// cfParameterSetNames returns the names of CFParameterSets separated by commas
+func cfParameterSetNames() string {
+	names := make([]string, len(CFParameterSets))
+	for i, set := range CFParameterSets {
+		names[i] = set.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// FindCFParameterSet()
+// Input: the name of a built-in Chou-Fasman parameter set ("" for DefaultCFParameterSet)
+// Output: the set, or an error listing the names of CFParameterSets if there is none by that name
+func FindCFParameterSet(name string) (CFParameterSet, error) {
+	if name == "" {
+		name = DefaultCFParameterSet
+	}
+	for _, set := range CFParameterSets {
+		if set.Name == name {
+			return set, nil
+		}
+	}
+	return CFParameterSet{}, fmt.Errorf("unknown Chou-Fasman parameter set %q: expected %s", name, cfParameterSetNames())
+}
+
+// SelectCFParameterSet()
+// Input: the name of a built-in Chou-Fasman parameter set (see FindCFParameterSet)
+// Output: an error if there is no set by that name; on success Predict uses its values for Chou-Fasman
+func (p *Predictor) SelectCFParameterSet(name string) error {
+	set, err := FindCFParameterSet(name)
+	if err != nil {
+		return err
+	}
+	p.CF = set.Parameters()
+	return nil
+}
@@ -0,0 +1,124 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// predictorFromFlags returns the predictor newPredictor builds from the parameter flags in args
+func predictorFromFlags(t *testing.T, args ...string) (*Predictor, error) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	params := addParameterFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		t.Fatalf("Failed to parse %v: %v", args, err)
+	}
+	return params.newPredictor()
+}
+
+func TestCFParameterSets(t *testing.T) {
+	for _, set := range CFParameterSets {
+		if set.Reference == "" {
+			t.Errorf("Set %s has no reference", set.Name)
+		}
+		for _, aa := range AminoAcidSymbols {
+			p, ok := set.Propensities[rune(aa[0])]
+			if !ok || p.alphaHelix <= 0 || p.betaSheet <= 0 || p.turn <= 0 {
+				t.Errorf("Set %s: missing or non-positive propensities for %s", set.Name, aa)
+			}
+			if _, ok := set.Bends[rune(aa[0])]; !ok {
+				t.Errorf("Set %s: missing bend probabilities for %s", set.Name, aa)
+			}
+		}
+	}
+
+	set, err := FindCFParameterSet("")
+	if err != nil || set.Name != DefaultCFParameterSet {
+		t.Errorf("Expected the empty name to select %s, got %q (%v)", DefaultCFParameterSet, set.Name, err)
+	}
+	if _, err := FindCFParameterSet("bogus"); err == nil || !strings.Contains(err.Error(), "expected original") {
+		t.Errorf("Expected an error listing the sets, got %v", err)
+	}
+}
+
+func TestSelectCFParameterSet(t *testing.T) {
+	sequence := []rune("MKTAYIAKQRQISFVKSHFSRQLEERLGLIEVQGNPDGSMEELAKKALELAKKG")
+	original := DefaultCFParameters().ChouFasmanPredictSS(sequence, CFOverlapScore)
+
+	// Start from parameters that predict no helices, which selecting the original set must replace
+	p := &Predictor{CF: CFParameters{Propensities: map[rune]AminoAcidPropensities{}, Bends: bendProbabilitiesTable}}
+	for aa, values := range propensities {
+		values.alphaHelix = 0.5
+		p.CF.Propensities[aa] = values
+	}
+	if got := p.CF.ChouFasmanPredictSS(sequence, CFOverlapScore); strings.Contains(got, "H") || !strings.Contains(original, "H") {
+		t.Fatalf("Expected helices only with the original set, got %s and %s", got, original)
+	}
+	if err := p.SelectCFParameterSet(DefaultCFParameterSet); err != nil {
+		t.Fatalf("SelectCFParameterSet returned error: %v", err)
+	}
+	if got := p.CF.ChouFasmanPredictSS(sequence, CFOverlapScore); got != original {
+		t.Errorf("Expected the original set to restore the prediction %s, got %s", original, got)
+	}
+	if err := p.SelectCFParameterSet("bogus"); err == nil {
+		t.Errorf("Expected an error for an unknown set")
+	}
+}
+
+func TestCFSetFlags(t *testing.T) {
+	dir := t.TempDir()
+	var out bytes.Buffer
+	if err := RunCLI([]string{"params", "-out", dir, "-cf-set", DefaultCFParameterSet}, &out); err != nil {
+		t.Fatalf("params returned error: %v", err)
+	}
+	cfFile := filepath.Join(dir, "cf_params.csv")
+	content, err := os.ReadFile(cfFile)
+	if err != nil {
+		t.Fatalf("Failed to read cf_params.csv: %v", err)
+	}
+	if !strings.Contains(string(content), "\nM,1.45,1.05,0.6,") {
+		t.Fatalf("Expected the built-in methionine values in cf_params.csv, got:\n%s", content)
+	}
+
+	// A set given on the command line replaces the parameter file of the config
+	edited := strings.Replace(string(content), "\nM,1.45,", "\nM,0.5,", 1)
+	if err := os.WriteFile(cfFile, []byte(edited), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	config := filepath.Join(dir, "config.json")
+	for _, test := range []struct {
+		args     []string
+		expected float64 // Pa of methionine
+	}{
+		{[]string{"-config", config}, 0.5},
+		{[]string{"-config", config, "-cf-set", DefaultCFParameterSet}, 1.45},
+	} {
+		p, err := predictorFromFlags(t, test.args...)
+		if err != nil {
+			t.Fatalf("newPredictor with %v returned error: %v", test.args, err)
+		}
+		if p.CF.Propensities['M'].alphaHelix != test.expected {
+			t.Errorf("%v: expected methionine Pa %v, got %+v", test.args, test.expected, p.CF.Propensities['M'])
+		}
+	}
+
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"predict", "-cf-set", DefaultCFParameterSet, "-cf-params", filepath.Join(dir, "cf_params.csv"), "MKTAYIAKQ"}, "not both"},
+		{[]string{"predict", "-cf-set", "bogus", "MKTAYIAKQ"}, "unknown Chou-Fasman parameter set"},
+		{[]string{"params", "-out", dir, "-cf-set", "bogus"}, "unknown Chou-Fasman parameter set"},
+	}
+	for _, test := range tests {
+		if err := RunCLI(test.args, &out); err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("%v: expected error containing %q, got %v", test.args, test.expected, err)
+		}
+	}
+}
@@ -5,9 +5,11 @@
 package main
 
 // ChouFasmanPredictSS()
-// Input: a slice of runes, each elements corresponds to an amino acid residue
-// Output: a string that predicts the secondary structure of a protein by employing the Chou-Fasman model
-func ChouFasmanPredictSS(sequence []rune) string {
+// Input: a slice of runes, each elements corresponds to an amino acid residue, and the overlap mode
+// (see CFOverlapModes)
+// Output: a string that predicts the secondary structure of a protein by employing the Chou-Fasman model with the
+// propensities and bend probabilities of cf
+func (cf CFParameters) ChouFasmanPredictSS(sequence []rune, overlap string) string {
 	length := len(sequence)
 	result := make([]rune, length)
 
@@ -17,14 +19,14 @@ func ChouFasmanPredictSS(sequence []rune) string {
 	}
 
 	// First, predict for each structure type respectively
-	helixRegions := PredictHelix(sequence)
+	helixRegions := cf.PredictHelix(sequence)
 
-	sheetRegions := PredictSheet(sequence)
+	sheetRegions := cf.PredictSheet(sequence)
 
-	turnRegions := PredictTurn(sequence)
+	turnRegions := cf.PredictTurn(sequence)
 
 	// Resolve the overlapping regions and assign structure types to the result slice accordingly
-	ClassifyOverlap(sequence, helixRegions, sheetRegions, turnRegions, result)
+	cf.resolveOverlaps(sequence, helixRegions, sheetRegions, turnRegions, result, overlap)
 
 	return string(result)
 }
@@ -32,14 +34,14 @@ func ChouFasmanPredictSS(sequence []rune) string {
 // PredictHelix()
 // Input: a slice of runes sequence
 // Output: a slice of Region datatypes of all the regions with high propensities of being an alpha helix
-func PredictHelix(sequence []rune) []Region {
+func (cf CFParameters) PredictHelix(sequence []rune) []Region {
 	var regions []Region
 
 	// Slide a 6-residue window across the sequence
 	for i := 0; i <= len(sequence)-6; i++ {
-		if IsHelix(sequence[i : i+6]) { // Check 6-residue window
+		if cf.IsHelix(sequence[i : i+6]) { // Check 6-residue window
 			// If it's a potential helix nucleation site, extend it from either sides
-			start, end, score := ExtendHelix(sequence, i)
+			start, end, score := cf.ExtendHelix(sequence, i)
 			regions = append(regions, Region{start, end, score, 'H'}) //Append the helix region
 			i = end - 1                                               // Skip ahead to avoid overlapping predictions
 		}
@@ -51,7 +53,7 @@ func PredictHelix(sequence []rune) []Region {
 // IsHelix()
 // Input: a window of length 6 (slice of runes)
 // Output: a boolean after checking if the window (nucleation site) has a high propensity of forming an alpha helix
-func IsHelix(window []rune) bool {
+func (cf CFParameters) IsHelix(window []rune) bool {
 	if len(window) < 6 {
 		return false
 	}
@@ -66,7 +68,7 @@ func IsHelix(window []rune) bool {
 		if aa == 'P' {
 			return false
 		}
-		prop := propensities[aa]
+		prop := cf.Propensities[aa]
 		avgPropensity += prop.alphaHelix
 
 		// Count helix formers and breakers
@@ -93,14 +95,14 @@ func IsHelix(window []rune) bool {
 // PredictSheet()
 // Input: a slice of runes sequence
 // Output: a slice of Region datatypes of all the regions with high propensities of being a beta sheet
-func PredictSheet(sequence []rune) []Region {
+func (cf CFParameters) PredictSheet(sequence []rune) []Region {
 	var regions []Region
 
 	// Slide a 5-residue window across the sequence
 	for i := 0; i <= len(sequence)-5; i++ { // 5 residue window
-		if IsSheet(sequence[i : i+5]) {
+		if cf.IsSheet(sequence[i : i+5]) {
 			// If it's a potential sheet nucleation site, extend the region
-			start, end, score := ExtendSheet(sequence, i)
+			start, end, score := cf.ExtendSheet(sequence, i)
 			regions = append(regions, Region{start, end, score, 'E'}) // Append sheet region
 			i = end - 1
 		}
@@ -112,7 +114,7 @@ func PredictSheet(sequence []rune) []Region {
 // IsSheet()
 // Input: a window of length 5 (slice of runes)
 // Output: a boolean after checking if the window (nucleation site) has a high propensity of forming a beta sheet
-func IsSheet(window []rune) bool {
+func (cf CFParameters) IsSheet(window []rune) bool {
 	if len(window) < 5 {
 		return false
 	}
@@ -123,7 +125,7 @@ func IsSheet(window []rune) bool {
 
 	// Analyze each amino acid in the window
 	for _, aa := range window {
-		prop := propensities[aa]
+		prop := cf.Propensities[aa]
 		avgPropensity += prop.betaSheet
 
 		// Count sheet formers and breakers
@@ -146,7 +148,7 @@ func IsSheet(window []rune) bool {
 // ExtendHelix()
 // Input: the sequence which is a slice of runes, and a int start corresponding to an index value in the slice
 // Output: the start and end ints of the helix, and a float corresponding to the score (avg propensity)
-func ExtendHelix(sequence []rune, start int) (int, int, float64) {
+func (cf CFParameters) ExtendHelix(sequence []rune, start int) (int, int, float64) {
 	// Start with initial 6-residue nucleation site
 	currentStart := start
 	currentEnd := start + 6
@@ -158,14 +160,14 @@ func ExtendHelix(sequence []rune, start int) (int, int, float64) {
 		// Try extending forward if not at sequence end
 		if currentEnd < len(sequence) {
 			forwardRegion := sequence[currentStart : currentEnd+1]
-			forwardProp := CalculateAveragePropensity(forwardRegion, 'H')
+			forwardProp := cf.CalculateAveragePropensity(forwardRegion, 'H')
 
 			// Check for tetrapeptide breakers in the forward direction
 			hasBreaker := false
 			if currentEnd+4 <= len(sequence) {
 				for i := currentEnd - 3; i <= currentEnd; i++ {
 					tetrapeptide := sequence[i : i+4]
-					tetraProp := CalculateAveragePropensity(tetrapeptide, 'H')
+					tetraProp := cf.CalculateAveragePropensity(tetrapeptide, 'H')
 					if tetraProp < 1.00 {
 						hasBreaker = true
 						break // If set of tetrapeptide breakers identified, then break the loop
@@ -183,7 +185,7 @@ func ExtendHelix(sequence []rune, start int) (int, int, float64) {
 		// Try extending backward if not at sequence start
 		if currentStart > 0 {
 			backwardRegion := sequence[currentStart-1 : currentEnd]
-			backwardProp := CalculateAveragePropensity(backwardRegion, 'H')
+			backwardProp := cf.CalculateAveragePropensity(backwardRegion, 'H')
 
 			// Check for tetrapeptide breakers in the backward direction
 			hasBreaker := false
@@ -191,7 +193,7 @@ func ExtendHelix(sequence []rune, start int) (int, int, float64) {
 				for i := currentStart - 3; i <= currentStart; i++ {
 					if i+4 <= currentEnd {
 						tetrapeptide := sequence[i : i+4]
-						tetraProp := CalculateAveragePropensity(tetrapeptide, 'H')
+						tetraProp := cf.CalculateAveragePropensity(tetrapeptide, 'H')
 						if tetraProp < 1.00 {
 							hasBreaker = true
 							break
@@ -214,7 +216,7 @@ func ExtendHelix(sequence []rune, start int) (int, int, float64) {
 
 	// Calculate final region score
 	finalRegion := sequence[currentStart:currentEnd]
-	totalScore := CalculateAveragePropensity(finalRegion, 'H')
+	totalScore := cf.CalculateAveragePropensity(finalRegion, 'H')
 
 	return currentStart, currentEnd, totalScore
 }
@@ -222,7 +224,7 @@ func ExtendHelix(sequence []rune, start int) (int, int, float64) {
 // ExtendSheet()
 // Input: the sequence which is a slice of runes, and a int start corresponding to an index value in the slice
 // Output: the start and end ints of the sheet, and a float corresponding to the score (avg propensity)
-func ExtendSheet(sequence []rune, start int) (int, int, float64) {
+func (cf CFParameters) ExtendSheet(sequence []rune, start int) (int, int, float64) {
 	// Start with initial 5-residue nucleus
 	currentStart := start
 	currentEnd := start + 5
@@ -234,14 +236,14 @@ func ExtendSheet(sequence []rune, start int) (int, int, float64) {
 		// Try extending forward if not at sequence end
 		if currentEnd < len(sequence) {
 			forwardRegion := sequence[currentStart : currentEnd+1]
-			forwardProp := CalculateAveragePropensity(forwardRegion, 'E')
+			forwardProp := cf.CalculateAveragePropensity(forwardRegion, 'E')
 
 			// Check for tetrapeptide breakers in the forward direction
 			hasBreaker := false
 			if currentEnd+4 <= len(sequence) {
 				for i := currentEnd - 3; i <= currentEnd; i++ {
 					tetrapeptide := sequence[i : i+4]
-					tetraProp := CalculateAveragePropensity(tetrapeptide, 'E')
+					tetraProp := cf.CalculateAveragePropensity(tetrapeptide, 'E')
 					if tetraProp < 1.00 {
 						hasBreaker = true
 						break
@@ -258,7 +260,7 @@ func ExtendSheet(sequence []rune, start int) (int, int, float64) {
 		// Try extending backward if not at sequence start
 		if currentStart > 0 {
 			backwardRegion := sequence[currentStart-1 : currentEnd]
-			backwardProp := CalculateAveragePropensity(backwardRegion, 'E')
+			backwardProp := cf.CalculateAveragePropensity(backwardRegion, 'E')
 
 			// Check for tetrapeptide breakers in the backward direction
 			hasBreaker := false
@@ -266,7 +268,7 @@ func ExtendSheet(sequence []rune, start int) (int, int, float64) {
 				for i := currentStart - 3; i <= currentStart; i++ {
 					if i+4 <= currentEnd {
 						tetrapeptide := sequence[i : i+4]
-						tetraProp := CalculateAveragePropensity(tetrapeptide, 'E')
+						tetraProp := cf.CalculateAveragePropensity(tetrapeptide, 'E')
 						if tetraProp < 1.00 {
 							hasBreaker = true
 							break
@@ -288,7 +290,7 @@ func ExtendSheet(sequence []rune, start int) (int, int, float64) {
 	}
 	// Calculate final region score
 	finalRegion := sequence[currentStart:currentEnd]
-	totalScore := CalculateAveragePropensity(finalRegion, 'E')
+	totalScore := cf.CalculateAveragePropensity(finalRegion, 'E')
 
 	return currentStart, currentEnd, totalScore
 }
@@ -296,13 +298,13 @@ func ExtendSheet(sequence []rune, start int) (int, int, float64) {
 // PredictTurn()
 // Input: sequence which is a slice of runes
 // Output: a slice of Region datatypes of all the regions likely of being turns
-func PredictTurn(sequence []rune) []Region {
+func (cf CFParameters) PredictTurn(sequence []rune) []Region {
 	var regions []Region
 
 	// Slide a 4-residue window across the sequence
 	for i := 0; i <= len(sequence)-4; i++ {
-		if IsTurn(sequence[i : i+4]) { // 4 residue window
-			regions = append(regions, Region{i, i + 4, CalculateAveragePropensity(sequence[i:i+4], 'T'), 'T'}) //Append the calculated score
+		if cf.IsTurn(sequence[i : i+4]) { // 4 residue window
+			regions = append(regions, Region{i, i + 4, cf.CalculateAveragePropensity(sequence[i:i+4], 'T'), 'T'}) //Append the calculated score
 		}
 	}
 
@@ -312,24 +314,24 @@ func PredictTurn(sequence []rune) []Region {
 // IsTurn()
 // Input: a window of length 4 (slice of runes)
 // Output: a boolean after checking if the window (nucleation site) has a high propensity of being a turn
-func IsTurn(window []rune) bool {
+func (cf CFParameters) IsTurn(window []rune) bool {
 	if len(window) < 4 {
 		return false
 	}
 
 	// Calculate positional probability of tetrapeptide being a turn
-	pt := bendProbabilitiesTable[window[0]].p1 *
-		bendProbabilitiesTable[window[1]].p2 *
-		bendProbabilitiesTable[window[2]].p3 *
-		bendProbabilitiesTable[window[3]].p4
+	pt := cf.Bends[window[0]].p1 *
+		cf.Bends[window[1]].p2 *
+		cf.Bends[window[2]].p3 *
+		cf.Bends[window[3]].p4
 
 	// Ensure the two middle residues meet a minimum bend probability
-	if propensities[window[1]].turn < 0.5 || propensities[window[2]].turn < 0.5 {
+	if cf.Propensities[window[1]].turn < 0.5 || cf.Propensities[window[2]].turn < 0.5 {
 		return false
 	}
 
 	// Calculate the average turn propensity
-	avgPropensity := CalculateAveragePropensity(window, 'T')
+	avgPropensity := cf.CalculateAveragePropensity(window, 'T')
 
 	// Check if the window meets turn formation criteria
 	return avgPropensity >= 1.0 && pt >= 0.000075
@@ -379,14 +381,14 @@ func ClassifyOverlap(sequence []rune, helixRegions, sheetRegions, turnRegions []
 // CalculateAveragePropensity()
 // Input: window which is a slice of runes, and a structureType rune
 // Output: the avergage propensities of all the residues in the window for being that structure type as a float64
-func CalculateAveragePropensity(window []rune, structureType rune) float64 {
+func (cf CFParameters) CalculateAveragePropensity(window []rune, structureType rune) float64 {
 	if len(window) == 0 {
 		return 0.0
 	}
 
 	sum := 0.0
 	for _, aa := range window {
-		prop := propensities[aa]
+		prop := cf.Propensities[aa]
 		switch structureType {
 		case 'H':
 			sum += prop.alphaHelix
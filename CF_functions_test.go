@@ -40,7 +40,7 @@ func TestChouFasmanPredictSS(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := ChouFasmanPredictSS(tt.sequence)
+			result := DefaultCFParameters().ChouFasmanPredictSS(tt.sequence, CFOverlapScore)
 			if result != tt.expected {
 				t.Errorf("Test %s failed. Expected %s but got %s", tt.name, tt.expected, result)
 			}
@@ -91,7 +91,7 @@ func TestPredictHelix(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := PredictHelix(tt.sequence)
+			result := DefaultCFParameters().PredictHelix(tt.sequence)
 
 			if !reflect.DeepEqual(normalizeRegions(result), normalizeRegions(tt.expected)) {
 				t.Errorf("Test %s failed. Expected %v but got %v", tt.name, tt.expected, result)
@@ -130,7 +130,7 @@ func TestIsHelix(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := IsHelix(tt.window)
+			result := DefaultCFParameters().IsHelix(tt.window)
 			if result != tt.expected {
 				t.Errorf("Test %s failed. Expected %v but got %v", tt.name, tt.expected, result)
 			}
@@ -174,7 +174,7 @@ func TestPredictSheet(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := PredictSheet(tt.sequence)
+			result := DefaultCFParameters().PredictSheet(tt.sequence)
 
 			if !reflect.DeepEqual(normalizeRegions(result), normalizeRegions(tt.expected)) {
 				t.Errorf("Test %s failed. Expected %v but got %v", tt.name, tt.expected, result)
@@ -213,7 +213,7 @@ func TestIsSheet(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := IsSheet(tt.window)
+			result := DefaultCFParameters().IsSheet(tt.window)
 			if result != tt.expected {
 				t.Errorf("Test %s failed. Expected %v but got %v", tt.name, tt.expected, result)
 			}
@@ -258,7 +258,7 @@ func TestPredictTurn(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := PredictTurn(tt.sequence)
+			result := DefaultCFParameters().PredictTurn(tt.sequence)
 
 			if !reflect.DeepEqual(normalizeRegions(result), normalizeRegions(tt.expected)) {
 				t.Errorf("Test %s failed. Expected %v but got %v", tt.name, tt.expected, result)
@@ -297,7 +297,7 @@ func TestIsTurn(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := IsTurn(tt.window)
+			result := DefaultCFParameters().IsTurn(tt.window)
 			if result != tt.expected {
 				t.Errorf("Test %s failed. Expected %v but got %v", tt.name, tt.expected, result)
 			}
@@ -340,7 +340,7 @@ func TestCalculateAveragePropensity(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := CalculateAveragePropensity(tt.window, tt.structureType)
+			result := DefaultCFParameters().CalculateAveragePropensity(tt.window, tt.structureType)
 
 			if result != tt.expected {
 				t.Errorf("Test %s failed. Expected %v but got %v", tt.name, tt.expected, result)
@@ -491,7 +491,7 @@ func TestExtendHelix(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			start, end, score := ExtendHelix(tt.sequence, tt.start)
+			start, end, score := DefaultCFParameters().ExtendHelix(tt.sequence, tt.start)
 
 			// Validate the start, end, and score
 			if start != tt.expectedStart {
@@ -552,7 +552,7 @@ func TestExtendSheet(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Run ExtendSheet and capture results
-			start, end, score := ExtendSheet(tt.sequence, tt.start)
+			start, end, score := DefaultCFParameters().ExtendSheet(tt.sequence, tt.start)
 
 			// Test start position
 			if start != tt.expectedStart {
@@ -0,0 +1,201 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// cifToken is one value or keyword of a CIF file. Quoted values are never keywords or tags.
+type cifToken struct {
+	Text   string
+	Quoted bool
+}
+
+// ParseCIF()
+// Input: an io.Reader with a CIF/mmCIF file
+// Output: the tables of the first data block keyed by category name (e.g. "_atom_site"), or an error
+// Both loop_ tables and single "_category.item value" pairs are returned; a category written as pairs
+// becomes a table with one row.
+func ParseCIF(r io.Reader) (map[string]*CIFTable, error) {
+	tokens, err := tokenizeCIF(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make(map[string]*CIFTable)
+	isKeyword := func(token cifToken) bool {
+		if token.Quoted {
+			return false
+		}
+		lower := strings.ToLower(token.Text)
+		return strings.HasPrefix(token.Text, "_") || lower == "loop_" || strings.HasPrefix(lower, "data_") ||
+			strings.HasPrefix(lower, "save_") || lower == "stop_" || lower == "global_"
+	}
+
+	blocks := 0
+	for i := 0; i < len(tokens); {
+		token := tokens[i]
+		lower := strings.ToLower(token.Text)
+		switch {
+		case !token.Quoted && strings.HasPrefix(lower, "data_"):
+			blocks++
+			if blocks > 1 {
+				return tables, nil // Only the first data block is read
+			}
+			i++
+
+		case !token.Quoted && lower == "loop_":
+			// Column names, then values until the next keyword
+			i++
+			var category string
+			var columns []string
+			for i < len(tokens) && !tokens[i].Quoted && strings.HasPrefix(tokens[i].Text, "_") {
+				name, item, err := splitCIFTag(tokens[i].Text)
+				if err != nil {
+					return nil, err
+				}
+				if category == "" {
+					category = name
+				} else if name != category {
+					return nil, fmt.Errorf("loop mixes categories %s and %s", category, name)
+				}
+				columns = append(columns, item)
+				i++
+			}
+			if len(columns) == 0 {
+				return nil, fmt.Errorf("loop_ without column names")
+			}
+			table := &CIFTable{Columns: columns}
+			var row []string
+			for i < len(tokens) && !isKeyword(tokens[i]) {
+				row = append(row, tokens[i].Text)
+				if len(row) == len(columns) {
+					table.Rows = append(table.Rows, row)
+					row = nil
+				}
+				i++
+			}
+			if len(row) != 0 {
+				return nil, fmt.Errorf("loop %s has %d values left over for %d columns", category, len(row), len(columns))
+			}
+			tables[category] = table
+
+		case !token.Quoted && strings.HasPrefix(token.Text, "_"):
+			// A single "_category.item value" pair
+			name, item, err := splitCIFTag(token.Text)
+			if err != nil {
+				return nil, err
+			}
+			if i+1 >= len(tokens) || isKeyword(tokens[i+1]) {
+				return nil, fmt.Errorf("tag %s has no value", token.Text)
+			}
+			table, ok := tables[name]
+			if !ok {
+				table = &CIFTable{Rows: [][]string{{}}}
+				tables[name] = table
+			}
+			if len(table.Rows) == 1 {
+				table.Columns = append(table.Columns, item)
+				table.Rows[0] = append(table.Rows[0], tokens[i+1].Text)
+			}
+			i += 2
+
+		default:
+			i++ // save_ frames and stray values are skipped
+		}
+	}
+	return tables, nil
+}
+
+// splitCIFTag splits "_category.item" into its two parts
+func splitCIFTag(tag string) (string, string, error) {
+	category, item, ok := strings.Cut(tag, ".")
+	if !ok || item == "" {
+		return "", "", fmt.Errorf("invalid CIF tag %s", tag)
+	}
+	return category, item, nil
+}
+
+// tokenizeCIF splits a CIF file into whitespace-separated tokens, honouring quotes, ;-delimited
+// text fields and # comments
+func tokenizeCIF(r io.Reader) ([]cifToken, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 1024*1024), 16*1024*1024) // mmCIF lines can be long
+
+	var tokens []cifToken
+	var text []string // Lines of an open ;-delimited text field
+	inText := false
+	lineNumber := 0
+	for scanner.Scan() {
+		line := scanner.Text()
+		lineNumber++
+
+		if inText {
+			if strings.HasPrefix(line, ";") {
+				tokens = append(tokens, cifToken{Text: strings.Join(text, "\n"), Quoted: true})
+				inText = false
+				line = line[1:]
+			} else {
+				text = append(text, line)
+				continue
+			}
+		} else if strings.HasPrefix(line, ";") {
+			inText = true
+			text = []string{line[1:]}
+			continue
+		}
+
+		for pos := 0; pos < len(line); {
+			c := line[pos]
+			switch {
+			case c == ' ' || c == '\t':
+				pos++
+			case c == '#':
+				pos = len(line) // Comment to the end of the line
+			case c == '\'' || c == '"':
+				// A quoted value ends at a matching quote followed by whitespace or the end of the line
+				end := pos + 1
+				for end < len(line) && !(line[end] == c && (end+1 == len(line) || line[end+1] == ' ' || line[end+1] == '\t')) {
+					end++
+				}
+				if end >= len(line) {
+					return nil, fmt.Errorf("line %d: unterminated quoted value", lineNumber)
+				}
+				tokens = append(tokens, cifToken{Text: line[pos+1 : end], Quoted: true})
+				pos = end + 1
+			default:
+				end := pos
+				for end < len(line) && line[end] != ' ' && line[end] != '\t' {
+					end++
+				}
+				tokens = append(tokens, cifToken{Text: line[pos:end]})
+				pos = end
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if inText {
+		return nil, fmt.Errorf("unterminated text field at end of file")
+	}
+	return tokens, nil
+}
+
+// Column()
+// Input: an item name of the table's category (e.g. "label_asym_id")
+// Output: the index of the column, or -1 if the table does not have it
+func (t *CIFTable) Column(item string) int {
+	for i, column := range t.Columns {
+		if column == item {
+			return i
+		}
+	}
+	return -1
+}
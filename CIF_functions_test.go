@@ -0,0 +1,71 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseCIF(t *testing.T) {
+	cif := `data_TEST
+# A comment
+_entry.id   TEST
+_struct.title 'A "quoted" title'
+loop_
+_atom_site.group_PDB
+_atom_site.label_atom_id
+_atom_site.label_comp_id
+ATOM N   MET
+ATOM "C1'" DA
+HETATM O HOH # trailing comment
+_citation.title
+;Text field
+over two lines
+;
+data_SECOND
+_entry.id SECOND
+`
+	tables, err := ParseCIF(strings.NewReader(cif))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	atoms := tables["_atom_site"]
+	if atoms == nil || !reflect.DeepEqual(atoms.Columns, []string{"group_PDB", "label_atom_id", "label_comp_id"}) {
+		t.Fatalf("Unexpected _atom_site table %+v", atoms)
+	}
+	expected := [][]string{{"ATOM", "N", "MET"}, {"ATOM", "C1'", "DA"}, {"HETATM", "O", "HOH"}}
+	if !reflect.DeepEqual(atoms.Rows, expected) {
+		t.Errorf("Expected rows %q but got %q", expected, atoms.Rows)
+	}
+	if atoms.Column("label_comp_id") != 2 || atoms.Column("missing") != -1 {
+		t.Errorf("Column lookup returned the wrong index")
+	}
+
+	// Single pairs, quoted values and text fields; only the first data block is read
+	if entry := tables["_entry"]; entry.Rows[0][0] != "TEST" {
+		t.Errorf("Expected entry id TEST but got %q", entry.Rows[0][0])
+	}
+	if title := tables["_struct"].Rows[0][0]; title != `A "quoted" title` {
+		t.Errorf("Unexpected title %q", title)
+	}
+	if text := tables["_citation"].Rows[0][0]; text != "Text field\nover two lines" {
+		t.Errorf("Unexpected text field %q", text)
+	}
+}
+
+func TestParseCIFErrors(t *testing.T) {
+	for _, cif := range []string{
+		"data_X\nloop_\n_a.b\n_a.c\n1 2 3\n",   // Values left over
+		"data_X\n_a.b 'unterminated\n",         // Unterminated quote
+		"data_X\n_a.b\n;text without an end\n", // Unterminated text field
+		"data_X\nloop_\n_a.b\n_c.d\n1 2\n",     // Mixed categories
+	} {
+		if _, err := ParseCIF(strings.NewReader(cif)); err == nil {
+			t.Errorf("Expected an error for %q", cif)
+		}
+	}
+}
@@ -0,0 +1,771 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// programName is the name used in usage messages
+const programName = "AbInitioPS"
+
+// reductionFlagUsage documents the -reduce flag shared by the commands that read DSSP labels
+const reductionFlagUsage = "DSSP label reduction: hetc, hec, hec-strict, hetc-strict, or a mapping like HGI:H,EB:E,*:C"
+
+// Command describes one subcommand of the binary
+type Command struct {
+	Name    string                                   // Name typed on the command line
+	Summary string                                   // One-line description shown in the command list
+	Run     func(args []string, out io.Writer) error // Parses the subcommand's flags and executes it
+}
+
+// Commands lists the subcommands in the order they are shown in the help text
+var Commands = []Command{
+	{Name: "predict", Summary: "predict secondary structure with Chou-Fasman, GOR and HMM", Run: runPredict},
+	{Name: "train", Summary: "train the HMM parameters from labeled sequences", Run: runTrain},
+	{Name: "gor-train", Summary: "estimate GOR information tables from labeled sequences", Run: runGORTrain},
+	{Name: "gor-calibrate", Summary: "fit the temperature of the GOR probabilities on held-out labeled sequences", Run: runGORCalibrate},
+	{Name: "gor-explain", Summary: "show how each window offset contributes to the GOR scores of a residue", Run: runGORExplain},
+	{Name: "evaluate", Summary: "measure prediction accuracy against a labeled dataset", Run: runEvaluate},
+	{Name: "assign", Summary: "assign DSSP secondary structure from PDB or mmCIF coordinates", Run: runAssign},
+	{Name: "params", Summary: "write the built-in parameters to files that can be edited and loaded back", Run: runParams},
+	{Name: "serve", Summary: "serve predictions over HTTP", Run: runServe},
+}
+
+// RunCLI()
+// Input: the command-line arguments without the program name, and the writer for regular output
+// Output: an error if the command fails
+// The first argument selects the subcommand. For compatibility with earlier versions, arguments that
+// do not name a subcommand (e.g. a bare sequence) are passed to predict.
+func RunCLI(args []string, out io.Writer) error {
+	if len(args) == 0 {
+		printUsage(os.Stderr)
+		return fmt.Errorf("no command given")
+	}
+
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		if len(args) > 1 {
+			if cmd := findCommand(args[1]); cmd != nil {
+				return cmd.Run([]string{"-h"}, out)
+			}
+			return fmt.Errorf("unknown command %q", args[1])
+		}
+		printUsage(out)
+		return nil
+	}
+
+	if cmd := findCommand(args[0]); cmd != nil {
+		return cmd.Run(args[1:], out)
+	}
+	return runPredict(args, out)
+}
+
+// findCommand returns the subcommand with the given name, or nil if there is none
+func findCommand(name string) *Command {
+	for i := range Commands {
+		if Commands[i].Name == name {
+			return &Commands[i]
+		}
+	}
+	return nil
+}
+
+// printUsage writes the list of subcommands
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: %s <command> [flags] [arguments]\n\nCommands:\n", programName)
+	for _, cmd := range Commands {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.Name, cmd.Summary)
+	}
+	fmt.Fprintf(w, "\nRun '%s help <command>' for the flags of a command.\n", programName)
+	fmt.Fprintf(w, "'%s <sequence>' is shorthand for '%s predict <sequence>'.\n", programName, programName)
+}
+
+// newFlagSet()
+// Input: the subcommand name, its argument synopsis and a description
+// Output: a flag.FlagSet that returns errors instead of exiting and prints a usage message for the subcommand
+func newFlagSet(name, synopsis, description string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	fs.Usage = func() {
+		w := fs.Output()
+		fmt.Fprintf(w, "Usage: %s %s %s\n\n%s\n", programName, name, synopsis, description)
+		hasFlags := false
+		fs.VisitAll(func(*flag.Flag) { hasFlags = true })
+		if hasFlags {
+			fmt.Fprintf(w, "\nFlags:\n")
+			fs.PrintDefaults()
+		}
+	}
+	return fs
+}
+
+// parseFlags parses args with fs and turns a help request into a nil error with done set
+func parseFlags(fs *flag.FlagSet, args []string) (done bool, err error) {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return true, nil
+		}
+		return true, err
+	}
+	return false, nil
+}
+
+// parameterFlags holds the flags that replace the built-in parameters of the predictors
+type parameterFlags struct {
+	fs          *flag.FlagSet
+	config      *string
+	gorDir      *string
+	gor3Dir     *string
+	constants   *string
+	temperature *float64
+	edge        *string
+	modelFile   *string
+	cfParams    *string
+	cfSet       *string
+	cfOverlap   *string
+}
+
+// addParameterFlags registers -config, -gor-dir, -gor3-dir, -gor-constants, -gor-temperature, -gor-edge, -model,
+// -cf-params, -cf-set and -cf-overlap on fs
+func addParameterFlags(fs *flag.FlagSet) *parameterFlags {
+	return &parameterFlags{
+		fs:          fs,
+		config:      fs.String("config", "", "JSON file naming parameter files (gor_dir, gor3_dir, gor_constants, gor_temperature, gor_edge, hmm_model, cf_params, cf_set, cf_overlap); flags given on the command line take precedence"),
+		gorDir:      fs.String("gor-dir", "", "directory holding the GOR InfoVal_*.csv tables (default: tables built into the binary)"),
+		gor3Dir:     fs.String("gor3-dir", "", "directory holding GOR III InfoPair_*.csv tables written by 'gor-train'; predicts GOR with pair information"),
+		constants:   fs.String("gor-constants", "", "GOR decision constants CSV written by 'gor-train -fit-constants', added to the GOR scores (default: none)"),
+		edge:        fs.String("gor-edge", GOREdgeSkip, "how GOR scores window positions beyond the termini: skip, normalize (scale by the positions inside) or terminus (terminus rows of tables from gor-train)"),
+		temperature: fs.Float64("gor-temperature", 0, "softmax temperature in centinats that turns GOR scores into probabilities, fitted with 'gor-calibrate' (default 100)"),
+		modelFile:   fs.String("model", "", "HMM model file written by 'train -out' (default: built-in HMM)"),
+		cfParams:    fs.String("cf-params", "", "Chou-Fasman parameter CSV written by 'params' (default: built-in Chou-Fasman values)"),
+		cfSet:       fs.String("cf-set", "", "built-in Chou-Fasman parameter set: "+cfParameterSetNames()+" (default "+DefaultCFParameterSet+")"),
+		cfOverlap:   fs.String("cf-overlap", CFOverlapScore, "how Chou-Fasman resolves overlapping regions: score (higher whole-region score) or average (average propensities over the overlap, turns first)"),
+	}
+}
+
+// newPredictor()
+// Input: the parsed parameter flags
+// Output: a Predictor with the built-in parameters, replaced by the files named in -config and then by the
+// parameter flags given on the command line, or an error if a file cannot be read
+func (f *parameterFlags) newPredictor() (*Predictor, error) {
+	var config ParameterConfig
+	if *f.config != "" {
+		var err error
+		if config, err = ReadParameterConfig(*f.config); err != nil {
+			return nil, err
+		}
+	}
+	cfParamsGiven, cfSetGiven := false, false
+	f.fs.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "gor-dir":
+			config.GORDir = *f.gorDir
+		case "gor3-dir":
+			config.GOR3Dir = *f.gor3Dir
+		case "gor-constants":
+			config.GORConstants = *f.constants
+		case "gor-temperature":
+			config.GORTemperature = *f.temperature
+		case "gor-edge":
+			config.GOREdge = *f.edge
+		case "model":
+			config.HMMModel = *f.modelFile
+		case "cf-params":
+			config.CFParams, cfParamsGiven = *f.cfParams, true
+		case "cf-set":
+			config.CFSet, cfSetGiven = *f.cfSet, true
+		case "cf-overlap":
+			config.CFOverlap = *f.cfOverlap
+		}
+	})
+	// Chou-Fasman values given on the command line replace those named in the config file
+	if cfParamsGiven && !cfSetGiven {
+		config.CFSet = ""
+	}
+	if cfSetGiven && !cfParamsGiven {
+		config.CFParams = ""
+	}
+
+	predictor, err := NewPredictor(config.GORDir, config.HMMModel)
+	if err != nil {
+		return nil, err
+	}
+	if config.GOR3Dir != "" {
+		if err := predictor.LoadGORPairParameters(config.GOR3Dir); err != nil {
+			return nil, err
+		}
+	}
+	if config.GORConstants != "" {
+		if predictor.GORConstants, err = ReadGORDecisionConstants(config.GORConstants); err != nil {
+			return nil, err
+		}
+	}
+	if config.GORTemperature < 0 {
+		return nil, fmt.Errorf("invalid GOR temperature %v: must be positive", config.GORTemperature)
+	}
+	predictor.GORTemperature = config.GORTemperature
+	if err := predictor.SetGOREdge(config.GOREdge); err != nil {
+		return nil, err
+	}
+	if err := predictor.SetCFOverlap(config.CFOverlap); err != nil {
+		return nil, err
+	}
+	if config.CFParams != "" && config.CFSet != "" {
+		return nil, fmt.Errorf("give either a Chou-Fasman parameter file or a built-in set, not both")
+	}
+	if config.CFSet != "" {
+		if err := predictor.SelectCFParameterSet(config.CFSet); err != nil {
+			return nil, err
+		}
+	}
+	if config.CFParams != "" {
+		if err := predictor.LoadCFParameters(config.CFParams); err != nil {
+			return nil, fmt.Errorf("error reading Chou-Fasman parameters: %v", err)
+		}
+	}
+	return predictor, nil
+}
+
+// runPredict implements the predict subcommand
+func runPredict(args []string, out io.Writer) error {
+	fs := newFlagSet("predict", "[flags] <sequence | FASTA file | ->",
+		"Predicts secondary structure with the Chou-Fasman, GOR and HMM methods.\n"+
+			"The input is a raw amino acid sequence, the path of a FASTA file, or - to read FASTA from stdin.\n"+
+			"With -msa the input is an alignment file (or -), and the query is its first row.")
+	format := fs.String("format", FormatText, "output format: text, json or tsv")
+	msa := fs.Bool("msa", false, "read the input as a multiple sequence alignment (aligned FASTA or Clustal) whose first row is the query; GOR averages its scores over the rows")
+	params := addParameterFlags(fs)
+	if done, err := parseFlags(fs, args); done {
+		return err
+	}
+
+	if *format != FormatText && *format != FormatJSON && *format != FormatTSV {
+		return fmt.Errorf("unknown output format %q: expected text, json or tsv", *format)
+	}
+	if fs.NArg() < 1 {
+		fs.Usage()
+		return fmt.Errorf("please provide a sequence, a FASTA file or -")
+	}
+
+	// Load GOR parameter files and the HMM
+	predictor, err := params.newPredictor()
+	if err != nil {
+		return err
+	}
+
+	if *msa {
+		alignment, err := ReadAlignmentFile(fs.Arg(0))
+		if err != nil {
+			return fmt.Errorf("error reading alignment: %v", err)
+		}
+		return WriteResults(out, *format, []PredictionResult{predictor.PredictAlignment(alignment)})
+	}
+
+	// Read the input records (a raw sequence is treated as a single record without an identifier)
+	records, err := ReadInputRecords(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("error reading input: %v", err)
+	}
+
+	results := make([]PredictionResult, len(records))
+	for i, record := range records {
+		results[i] = predictor.Predict(record)
+	}
+
+	return WriteResults(out, *format, results)
+}
+
+// runTrain implements the train subcommand
+func runTrain(args []string, out io.Writer) error {
+	fs := newFlagSet("train", "[flags]",
+		"Trains the HMM initial, transition and emission probabilities from labeled sequences\n"+
+			"and prints the trained parameters. Without -data the built-in example set is used.\n"+
+			"Every count starts at a pseudocount (-pseudocount, default 1 for Laplace smoothing);\n"+
+			"proteins with mismatched lengths or unknown residues or labels are rejected.\n"+
+			"With -unlabeled, the supervised estimates are then refined with Baum-Welch on the\n"+
+			"sequences of a FASTA file, and the log-likelihood of every iteration is printed.")
+	dataFile := fs.String("data", "", "CSV file with ProteinSequence and DSSPSequence columns (default: built-in examples)")
+	dsspPaths := fs.String("dssp", "", "comma-separated DSSP files (classic or mkdssp mmCIF), PDB/mmCIF coordinate files or directories to train on instead of -data")
+	chain := fs.String("chain", "", "chain to read from the -dssp files (default: every chain)")
+	unlabeledFile := fs.String("unlabeled", "", "FASTA file of unlabeled sequences for Baum-Welch refinement")
+	maxIterations := fs.Int("max-iterations", 100, "maximum number of Baum-Welch iterations")
+	tolerance := fs.Float64("tolerance", 1e-4, "stop Baum-Welch when the log-likelihood improves by less than this")
+	bwPseudocount := fs.Float64("bw-pseudocount", 0, "pseudocount added to the Baum-Welch expected counts")
+	outFile := fs.String("out", "", "write the trained HMM to this model file (JSON)")
+	pseudocount := fs.Float64("pseudocount", 1, "pseudocount added to every supervised count (1 = Laplace smoothing, 0 = raw frequencies)")
+	initialPseudocount := fs.Float64("initial-pseudocount", -1, "pseudocount for initial-state counts (default: -pseudocount)")
+	transitionPseudocount := fs.Float64("transition-pseudocount", -1, "pseudocount for transition counts (default: -pseudocount)")
+	emissionPseudocount := fs.Float64("emission-pseudocount", -1, "pseudocount for emission counts (default: -pseudocount)")
+	reduce := fs.String("reduce", DefaultReductionScheme, reductionFlagUsage)
+	if done, err := parseFlags(fs, args); done {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+
+	proteins := make([]LabeledProtein, len(defaultTrainingSequences))
+	for i := range defaultTrainingSequences {
+		proteins[i] = LabeledProtein{
+			Name:     fmt.Sprintf("built-in example %d", i+1),
+			Sequence: defaultTrainingSequences[i],
+			Labels:   defaultTrainingLabels[i],
+		}
+	}
+	if *dataFile != "" || *dsspPaths != "" {
+		var err error
+		proteins, err = readGroundTruth(*dataFile, *dsspPaths, *chain)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Reduce the DSSP labels to the classes that become the HMM states
+	scheme, err := ParseReductionScheme(*reduce)
+	if err != nil {
+		return err
+	}
+	proteins = ReduceDataset(proteins, scheme)
+
+	// Per-parameter pseudocounts fall back to -pseudocount when not given
+	options := SupervisedOptions{
+		InitialPseudocount:    *initialPseudocount,
+		TransitionPseudocount: *transitionPseudocount,
+		EmissionPseudocount:   *emissionPseudocount,
+	}
+	for _, value := range []*float64{&options.InitialPseudocount, &options.TransitionPseudocount, &options.EmissionPseudocount} {
+		if *value < 0 {
+			*value = *pseudocount
+		}
+	}
+
+	// Initialize HMM with the single-letter label states used by the training data
+	hmm := NewHMM1(scheme.Classes, AminoAcidSymbols)
+
+	// Train HMM from the labeled sequences
+	if err := hmm.TrainSupervised(proteins, options); err != nil {
+		return fmt.Errorf("supervised training failed: %v", err)
+	}
+
+	provenance := HMMProvenance{
+		CreatedAt:    time.Now().UTC().Format(time.RFC3339),
+		Method:       "supervised",
+		TrainingData: *dataFile + *dsspPaths,
+		Sequences:    len(proteins),
+		Notes: fmt.Sprintf("reduction %s; pseudocounts: initial %g, transition %g, emission %g",
+			scheme.Name, options.InitialPseudocount, options.TransitionPseudocount, options.EmissionPseudocount),
+	}
+	if provenance.TrainingData == "" {
+		provenance.TrainingData = "built-in examples"
+	}
+
+	// Optionally refine the parameters on unlabeled sequences
+	if *unlabeledFile != "" {
+		records, err := ReadFASTAFile(*unlabeledFile)
+		if err != nil {
+			return err
+		}
+		unlabeled := make([]string, len(records))
+		for i, record := range records {
+			unlabeled[i] = strings.ToUpper(record.Sequence)
+		}
+
+		result, err := hmm.BaumWelch(unlabeled, BaumWelchOptions{
+			MaxIterations: *maxIterations,
+			Tolerance:     *tolerance,
+			Pseudocount:   *bwPseudocount,
+			Progress: func(iteration int, logLikelihood float64) {
+				fmt.Fprintf(out, "Baum-Welch iteration %d: log-likelihood %.6f\n", iteration, logLikelihood)
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Baum-Welch training failed: %v", err)
+		}
+		provenance.Method = "supervised+baum-welch"
+		provenance.UnlabeledData = *unlabeledFile
+		provenance.Iterations = result.Iterations
+		provenance.LogLikelihood = result.LogLikelihoods[len(result.LogLikelihoods)-1]
+		if result.Converged {
+			fmt.Fprintf(out, "Baum-Welch converged after %d iterations\n", result.Iterations)
+		} else {
+			fmt.Fprintf(out, "Baum-Welch stopped after %d iterations without converging\n", result.Iterations)
+		}
+	}
+
+	if *outFile != "" {
+		if err := SaveHMMModel(*outFile, hmm, provenance); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Saved HMM model to %s\n", *outFile)
+	}
+
+	return WriteHMMParameters(out, hmm)
+}
+
+// runGORTrain implements the gor-train subcommand
+func runGORTrain(args []string, out io.Writer) error {
+	fs := newFlagSet("gor-train", "[flags]",
+		"Estimates GOR information tables (in centinats) from residue and label counts over labeled\n"+
+			"sequences and writes them to the -out directory. -method gor1 writes the InfoVal_*.csv\n"+
+			"tables read by -gor-dir; -method gor3 writes the InfoPair_*.csv pair tables read by -gor3-dir.\n"+
+			"The window width (-window) is written to the table header, where the predictors read it from.\n"+
+			"Labels are reduced with -reduce, whose classes must be among H, E, T and C. With\n"+
+			"-fit-constants, decision constants are fitted so that GOR with the new tables predicts each\n"+
+			"class in the proportion of the training labels. Estimate the tables from proteins other than\n"+
+			"those you evaluate on, or the accuracy will be overestimated.")
+	dataFile := fs.String("data", "AccuracyTestDataset_50.csv", "labeled CSV dataset")
+	dsspPaths := fs.String("dssp", "", "comma-separated DSSP files (classic or mkdssp mmCIF), PDB/mmCIF coordinate files or directories to use instead of -data")
+	chain := fs.String("chain", "", "chain to read from the -dssp files (default: every chain)")
+	method := fs.String("method", "gor1", "tables to estimate: gor1 (single residues) or gor3 (residue pairs)")
+	outDir := fs.String("out", "", "directory to write the tables to (required)")
+	pseudocount := fs.Float64("pseudocount", 1, "pseudocount added to every residue and residue pair count")
+	window := fs.Int("window", 17, "window width, an odd number of offsets centred on the residue (17 = -8..+8)")
+	reduce := fs.String("reduce", DefaultReductionScheme, reductionFlagUsage)
+	edge := fs.String("gor-edge", GOREdgeSkip, "edge mode (skip, normalize or terminus) GOR uses when fitting -fit-constants; predict with the same -gor-edge")
+	fitConstants := fs.Bool("fit-constants", false, "also fit GOR decision constants that make the predicted class proportions match the training set, written to "+gorConstantsFile+" for -gor-constants")
+	if done, err := parseFlags(fs, args); done {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	if *method != "gor1" && *method != "gor3" {
+		return fmt.Errorf("unknown method %q: expected gor1 or gor3", *method)
+	}
+	if _, err := ParseGOREdgeMode(*edge); err != nil {
+		return err
+	}
+	if *outDir == "" {
+		return fmt.Errorf("no output directory given (use -out)")
+	}
+
+	scheme, err := ParseReductionScheme(*reduce)
+	if err != nil {
+		return err
+	}
+	if *dsspPaths != "" {
+		*dataFile = "" // -dssp replaces the default dataset
+	}
+	proteins, err := readGroundTruth(*dataFile, *dsspPaths, *chain)
+	if err != nil {
+		return err
+	}
+	proteins = ReduceDataset(proteins, scheme)
+
+	var predict func(sequence string) ([]GORPredictionResult, error) // GOR with the estimated tables
+	if *method == "gor3" {
+		tables, err := EstimateGORPairParameters(proteins, *window, *pseudocount)
+		if err != nil {
+			return err
+		}
+		if err := SaveGORPairParameters(*outDir, tables); err != nil {
+			return err
+		}
+		predict = func(sequence string) ([]GORPredictionResult, error) {
+			return GORPairPredictEdge(sequence, *edge, tables[0], tables[1], tables[2], tables[3])
+		}
+	} else {
+		tables, err := EstimateGORParameters(proteins, *window, *pseudocount)
+		if err != nil {
+			return err
+		}
+		if err := SaveGORParameters(*outDir, tables); err != nil {
+			return err
+		}
+		predict = func(sequence string) ([]GORPredictionResult, error) {
+			return GORPredictEdge(sequence, *edge, tables[0], tables[1], tables[2], tables[3])
+		}
+	}
+	fmt.Fprintf(out, "Wrote %s tables estimated from %d proteins (reduction %s) to %s\n", *method, len(proteins), scheme.Name, *outDir)
+
+	if *fitConstants {
+		composition, err := LabelComposition(proteins)
+		if err != nil {
+			return err
+		}
+		var predictions []GORPredictionResult // Pooled over the training proteins
+		for _, protein := range proteins {
+			gorPredictions, err := predict(strings.ToUpper(protein.Sequence))
+			if err != nil {
+				return fmt.Errorf("protein %s: %v", protein.Name, err)
+			}
+			predictions = append(predictions, gorPredictions...)
+		}
+		constants, err := FitGORDecisionConstants(predictions, composition)
+		if err != nil {
+			return err
+		}
+		filename := filepath.Join(*outDir, gorConstantsFile)
+		if err := SaveGORDecisionConstants(filename, constants); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Wrote decision constants H %.1f, E %.1f, T %.1f, C %.1f (training composition H %.3f, E %.3f, T %.3f, C %.3f) to %s\n",
+			constants.Alpha, constants.Beta, constants.Turn, constants.Coil,
+			composition[0], composition[1], composition[2], composition[3], filename)
+	}
+	return nil
+}
+
+// runGORCalibrate implements the gor-calibrate subcommand
+func runGORCalibrate(args []string, out io.Writer) error {
+	fs := newFlagSet("gor-calibrate", "[flags]",
+		"Fits the softmax temperature that turns GOR scores into class probabilities, by minimising the\n"+
+			"log loss of the labels of a labeled CSV dataset or DSSP files, and reports the accuracy of each\n"+
+			"reliability index. Use proteins the GOR tables were not estimated from, and pass the fitted\n"+
+			"value to the other commands with -gor-temperature.")
+	dataFile := fs.String("data", "AccuracyTestDataset_50.csv", "labeled CSV dataset")
+	dsspPaths := fs.String("dssp", "", "comma-separated DSSP files (classic or mkdssp mmCIF), PDB/mmCIF coordinate files or directories to use instead of -data")
+	chain := fs.String("chain", "", "chain to read from the -dssp files (default: every chain)")
+	reduce := fs.String("reduce", DefaultReductionScheme, reductionFlagUsage)
+	params := addParameterFlags(fs)
+	if done, err := parseFlags(fs, args); done {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+
+	predictor, err := params.newPredictor()
+	if err != nil {
+		return err
+	}
+	scheme, err := ParseReductionScheme(*reduce)
+	if err != nil {
+		return err
+	}
+	if *dsspPaths != "" {
+		*dataFile = "" // -dssp replaces the default dataset
+	}
+	proteins, err := readGroundTruth(*dataFile, *dsspPaths, *chain)
+	if err != nil {
+		return err
+	}
+
+	calibration, err := CalibrateGOR(predictor, proteins, scheme)
+	if err != nil {
+		return err
+	}
+	return WriteGORCalibration(out, calibration)
+}
+
+// runGORExplain implements the gor-explain subcommand
+func runGORExplain(args []string, out io.Writer) error {
+	fs := newFlagSet("gor-explain", "[flags] -position <n> <sequence | FASTA file | ->",
+		"Shows what every window offset adds to the four GOR scores of the residue at -position, with the\n"+
+			"decision constants, the final scores and the margin between the two highest, using the same\n"+
+			"GOR parameters as predict. A FASTA input explains that position in every record.")
+	position := fs.Int("position", 0, "position (from 1) of the residue to explain")
+	format := fs.String("format", FormatText, "output format: text or json")
+	params := addParameterFlags(fs)
+	if done, err := parseFlags(fs, args); done {
+		return err
+	}
+
+	if *format != FormatText && *format != FormatJSON {
+		return fmt.Errorf("unknown output format %q: expected text or json", *format)
+	}
+	if *position < 1 {
+		fs.Usage()
+		return fmt.Errorf("please give the position of the residue to explain with -position")
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("please provide a sequence, a FASTA file or -")
+	}
+
+	predictor, err := params.newPredictor()
+	if err != nil {
+		return err
+	}
+	records, err := ReadInputRecords(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("error reading input: %v", err)
+	}
+
+	explanations := make([]GORExplanation, len(records))
+	for i, record := range records {
+		if explanations[i], err = predictor.ExplainGOR(record, *position); err != nil {
+			if record.ID != "" {
+				return fmt.Errorf("record %s: %v", record.ID, err)
+			}
+			return err
+		}
+	}
+	return WriteGORExplanations(out, *format, explanations)
+}
+
+// runEvaluate implements the evaluate subcommand
+func runEvaluate(args []string, out io.Writer) error {
+	fs := newFlagSet("evaluate", "[flags]",
+		"Runs Chou-Fasman, GOR and HMM on every protein of a labeled CSV dataset\n"+
+			"(ProteinName, ProteinSequence, DSSPSequence) or of DSSP files, and reports Q3 and Q4\n"+
+			"accuracy, SOV, and the precision, recall, F1 score and MCC of each structure class,\n"+
+			"per protein and overall, with the confusion matrix of each method.")
+	dataFile := fs.String("data", "AccuracyTestDataset_50.csv", "labeled CSV dataset")
+	dsspPaths := fs.String("dssp", "", "comma-separated DSSP files (classic or mkdssp mmCIF), PDB/mmCIF coordinate files or directories to use instead of -data")
+	chain := fs.String("chain", "", "chain to read from the -dssp files (default: every chain)")
+	reduce := fs.String("reduce", DefaultReductionScheme, reductionFlagUsage)
+	format := fs.String("format", FormatText, "output format: text, json or tsv")
+	params := addParameterFlags(fs)
+	if done, err := parseFlags(fs, args); done {
+		return err
+	}
+	if *format != FormatText && *format != FormatJSON && *format != FormatTSV {
+		return fmt.Errorf("unknown output format %q: expected text, json or tsv", *format)
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+
+	predictor, err := params.newPredictor()
+	if err != nil {
+		return err
+	}
+	scheme, err := ParseReductionScheme(*reduce)
+	if err != nil {
+		return err
+	}
+	if *dsspPaths != "" {
+		*dataFile = "" // -dssp replaces the default dataset
+	}
+	proteins, err := readGroundTruth(*dataFile, *dsspPaths, *chain)
+	if err != nil {
+		return err
+	}
+
+	return WriteEvaluation(out, *format, EvaluateProteins(predictor, proteins, scheme))
+}
+
+// runAssign implements the assign subcommand
+func runAssign(args []string, out io.Writer) error {
+	fs := newFlagSet("assign", "[flags] <PDB or mmCIF file>...",
+		"Assigns DSSP secondary structure (H, G, I, E, B, T, S or -) to every residue of PDB or mmCIF\n"+
+			"coordinate files from their backbone hydrogen bonds, as the Kabsch-Sander DSSP program does.\n"+
+			"The csv format writes the labeled dataset layout read by 'train -data' and 'evaluate -data';\n"+
+			"coordinate files can also be given to 'evaluate -dssp' directly.")
+	format := fs.String("format", FormatText, "output format: text, json, tsv or csv")
+	chain := fs.String("chain", "", "chain to assign (default: every chain)")
+	if done, err := parseFlags(fs, args); done {
+		return err
+	}
+	if *format != FormatText && *format != FormatJSON && *format != FormatTSV && *format != FormatCSV {
+		return fmt.Errorf("unknown output format %q: expected text, json, tsv or csv", *format)
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return fmt.Errorf("no structure file given")
+	}
+
+	var structures []AssignedStructure
+	for _, file := range fs.Args() {
+		backbone, err := ReadStructureFile(file)
+		if err != nil {
+			return err
+		}
+		structure := AssignedStructure{Name: strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))}
+		for _, residue := range AssignSecondaryStructure(backbone) {
+			if *chain == "" || residue.Chain == *chain {
+				structure.Residues = append(structure.Residues, residue)
+			}
+		}
+		if len(structure.Residues) == 0 {
+			return fmt.Errorf("%s: chain %s not found", file, *chain)
+		}
+		structures = append(structures, structure)
+	}
+	return WriteAssignments(out, *format, structures)
+}
+
+// readGroundTruth reads labeled proteins from a CSV dataset or from DSSP files, but not both
+func readGroundTruth(dataFile, dsspPaths, chain string) ([]LabeledProtein, error) {
+	if dataFile != "" && dsspPaths != "" {
+		return nil, fmt.Errorf("use either -data or -dssp, not both")
+	}
+	if dsspPaths != "" {
+		return LoadDSSPProteins(strings.Split(dsspPaths, ","), chain)
+	}
+	return ReadLabeledDataset(dataFile)
+}
+
+// runParams implements the params subcommand
+func runParams(args []string, out io.Writer) error {
+	fs := newFlagSet("params", "[flags]",
+		"Writes the parameters built into the binary to the -out directory: the GOR tables\n"+
+			"(GOR_InfoVals/), the Chou-Fasman propensities and bend probabilities (cf_params.csv),\n"+
+			"the HMM (hmm_model.json) and a config.json naming them. Edit the files and pass\n"+
+			"-config <dir>/config.json to predict, evaluate or serve to use them.")
+	outDir := fs.String("out", "", "directory to write the parameter files to (required)")
+	cfSet := fs.String("cf-set", DefaultCFParameterSet, "built-in Chou-Fasman parameter set to write to cf_params.csv: "+cfParameterSetNames())
+	if done, err := parseFlags(fs, args); done {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	if *outDir == "" {
+		return fmt.Errorf("no output directory given (use -out)")
+	}
+	set, err := FindCFParameterSet(*cfSet)
+	if err != nil {
+		return err
+	}
+	if err := ExportDefaultParameters(*outDir, set.Parameters()); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Wrote the built-in parameters and config.json to %s\n", *outDir)
+	return nil
+}
+
+// runServe implements the serve subcommand
+func runServe(args []string, out io.Writer) error {
+	fs := newFlagSet("serve", "[flags]",
+		"Serves predictions over HTTP. POST a raw sequence or FASTA text to /predict\n"+
+			"(optionally with ?format=json|tsv|text); GET /health reports liveness.")
+	addr := fs.String("addr", ":8080", "address to listen on")
+	params := addParameterFlags(fs)
+	if done, err := parseFlags(fs, args); done {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+
+	predictor, err := params.newPredictor()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Listening on %s\n", *addr)
+	return NewServer(*addr, predictor).ListenAndServe()
+}
+
+// WriteHMMParameters()
+// Input: a writer and a trained HMM
+// Output: an error if writing fails
+// Prints the initial, transition and emission probabilities, one state per line.
+func WriteHMMParameters(w io.Writer, hmm *HMM) error {
+	initial, transition, emission := hmm.Probabilities() // Convert back from log space
+
+	var b strings.Builder
+	fmt.Fprintln(&b, "Trained Initial Probabilities:", initial)
+	fmt.Fprintln(&b, "Trained Transition Probabilities:")
+	for i := 0; i < len(hmm.States); i++ {
+		fmt.Fprintf(&b, "%s: %v\n", hmm.States[i], transition[i])
+	}
+	fmt.Fprintln(&b, "Trained Emission Probabilities:")
+	for i := 0; i < len(hmm.States); i++ {
+		fmt.Fprintf(&b, "%s: %v\n", hmm.States[i], emission[i])
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,76 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRunCLI(t *testing.T) {
+	tests := []struct {
+		name     string   // Name of the test case
+		args     []string // Command-line arguments
+		contains []string // Substrings expected in the output
+		wantErr  bool     // Whether an error is expected
+	}{
+		{
+			name:     "Bare sequence runs predict",
+			args:     []string{"AAAAAAGGG"},
+			contains: []string{"Input sequence: AAAAAAGGG", "Predicted Chou-Fasman secondary structure: ", "Predicted GOR secondary structure: ", "Predicted HMM secondary structure: "},
+		},
+		{
+			name:     "Predict subcommand with JSON output",
+			args:     []string{"predict", "-format", "json", "AAAAAAGGG"},
+			contains: []string{`"sequence": "AAAAAAGGG"`, `"gor_scores"`},
+		},
+		{
+			name:     "Train subcommand on built-in examples",
+			args:     []string{"train"},
+			contains: []string{"Trained Initial Probabilities:", "Trained Transition Probabilities:", "Trained Emission Probabilities:"},
+		},
+		{
+			name:     "Help lists commands",
+			args:     []string{"help"},
+			contains: []string{"predict", "train", "evaluate", "serve"},
+		},
+		{
+			name:    "Predict without input",
+			args:    []string{"predict"},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown format",
+			args:    []string{"predict", "-format", "xml", "AAA"},
+			wantErr: true,
+		},
+		{
+			name:    "No arguments",
+			args:    []string{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			err := RunCLI(tt.args, &out)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Test %s failed. Expected an error", tt.name)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Test %s failed with error: %v", tt.name, err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("Test %s failed. Output does not contain %q:\n%s", tt.name, want, out.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,466 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Constants of the Kabsch-Sander hydrogen bond model, as used by DSSP
+const (
+	hbondCoupling      = -27.888 // -332 * 0.42 * 0.2, kcal/mol * Angstrom
+	hbondMaxEnergy     = -0.5    // A bond needs an energy below this, in kcal/mol
+	hbondMinEnergy     = -9.9    // Lower bound on the energy, used when atoms clash
+	hbondMinDistance   = 0.5     // Atom distances below this are treated as a clash, in Angstrom
+	hbondMaxCADistance = 9.0     // Residues whose alpha carbons are further apart are not tested
+	maxPeptideBond     = 2.5     // Longest C-N distance between consecutive residues without a chain break
+	bendMinAngle       = 70.0    // Minimal CA(i-2)-CA(i)-CA(i+2) direction change of a bend, in degrees
+)
+
+// hbondPartner is one of the two strongest hydrogen bonds recorded for a residue
+type hbondPartner struct {
+	Residue int
+	Energy  float64
+}
+
+// bridgeType distinguishes parallel and antiparallel beta bridges
+type bridgeType int
+
+const (
+	noBridge bridgeType = iota
+	parallelBridge
+	antiparallelBridge
+)
+
+// ladder is a run of consecutive beta bridges of one type between residues iStart..iEnd and jStart..jEnd
+type ladder struct {
+	Type         bridgeType
+	Bridges      int // Number of bridges in the ladder
+	IStart, IEnd int
+	JStart, JEnd int
+}
+
+// dsspState holds the intermediate results of the assignment of one structure
+type dsspState struct {
+	residues []BackboneResidue
+	hydrogen []Vector3
+	breaks   []bool            // breaks[i] is true if the chain is broken between residue i-1 and i
+	donors   [][2]hbondPartner // Two best acceptors (C=O) bonded to the N-H of each residue
+}
+
+// AssignSecondaryStructure()
+// Input: backbone residues in chain order, as read by ReadStructureFile
+// Output: one DSSPResidue per input residue, with the Kabsch-Sander (DSSP) code H (alpha helix), G (3-10 helix),
+// I (pi helix), E (strand), B (isolated bridge), T (turn), S (bend) or - (none)
+//
+// Hydrogen bonds are found with the electrostatic energy E = q1q2 (1/rON + 1/rCH - 1/rOH - 1/rCN) * f
+// with a -0.5 kcal/mol cutoff, keeping the two strongest bonds of each residue. An n-turn at i is a bond
+// from C=O(i) to N-H(i+n); two consecutive n-turns start a helix, and pairs of bonds between residues
+// far apart in sequence form beta bridges, which are joined into ladders (E) or left isolated (B).
+// Codes are given in the priority order H, B/E, G, I, T, S.
+func AssignSecondaryStructure(residues []BackboneResidue) []DSSPResidue {
+	state := &dsspState{residues: residues}
+	state.findBreaks()
+	state.placeHydrogens()
+	state.calculateHBonds()
+
+	n := len(residues)
+	hbond := func(acceptor, donor int) bool { return state.hbond(acceptor, donor) }
+	codes := assignFromHBonds(n, hbond, state.noBreak, state.bends())
+
+	assigned := make([]DSSPResidue, n)
+	for i, residue := range residues {
+		assigned[i] = DSSPResidue{
+			Chain:     residue.Chain,
+			Number:    residue.Number,
+			AminoAcid: residue.AminoAcid,
+			Structure: codes[i],
+			Break:     i > 0 && state.breaks[i] && residues[i-1].Chain == residue.Chain,
+		}
+	}
+	return assigned
+}
+
+// findBreaks marks a chain break wherever the chain changes or consecutive residues are not peptide bonded
+func (s *dsspState) findBreaks() {
+	s.breaks = make([]bool, len(s.residues))
+	for i := 1; i < len(s.residues); i++ {
+		previous, current := s.residues[i-1], s.residues[i]
+		s.breaks[i] = previous.Chain != current.Chain || distance(previous.C, current.N) > maxPeptideBond
+	}
+}
+
+// noBreak reports whether residues from..to are connected without a chain break
+func (s *dsspState) noBreak(from, to int) bool {
+	if from < 0 || to >= len(s.residues) {
+		return false
+	}
+	for i := from + 1; i <= to; i++ {
+		if s.breaks[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// placeHydrogens puts the amide hydrogen 1 Angstrom from N, opposite the previous carbonyl O=C direction.
+// As in DSSP, the first residue of a chain has its hydrogen on the nitrogen.
+func (s *dsspState) placeHydrogens() {
+	s.hydrogen = make([]Vector3, len(s.residues))
+	for i, residue := range s.residues {
+		s.hydrogen[i] = residue.N
+		if i > 0 && !s.breaks[i] {
+			previous := s.residues[i-1]
+			direction := sub(previous.C, previous.O)
+			s.hydrogen[i] = add(residue.N, scale(direction, 1/norm(direction)))
+		}
+	}
+}
+
+// hbondEnergy returns the energy of the bond from the C=O of acceptor to the N-H of donor, in kcal/mol
+func (s *dsspState) hbondEnergy(donor, acceptor int) float64 {
+	d, a := s.residues[donor], s.residues[acceptor]
+	h := s.hydrogen[donor]
+	distanceHO, distanceHC := distance(h, a.O), distance(h, a.C)
+	distanceNC, distanceNO := distance(d.N, a.C), distance(d.N, a.O)
+	if distanceHO < hbondMinDistance || distanceHC < hbondMinDistance || distanceNC < hbondMinDistance || distanceNO < hbondMinDistance {
+		return hbondMinEnergy
+	}
+	energy := hbondCoupling/distanceHO - hbondCoupling/distanceHC + hbondCoupling/distanceNC - hbondCoupling/distanceNO
+	energy = math.Round(energy*1000) / 1000 // DSSP rounds to three decimals
+	return math.Max(energy, hbondMinEnergy)
+}
+
+// calculateHBonds records the two strongest acceptors of every residue's N-H.
+// Proline has no amide hydrogen and never donates.
+func (s *dsspState) calculateHBonds() {
+	n := len(s.residues)
+	s.donors = make([][2]hbondPartner, n)
+	for i := range s.donors {
+		s.donors[i] = [2]hbondPartner{{Residue: -1}, {Residue: -1}}
+	}
+
+	record := func(donor, acceptor int) {
+		if s.residues[donor].AminoAcid == 'P' {
+			return
+		}
+		energy := s.hbondEnergy(donor, acceptor)
+		best := &s.donors[donor]
+		if energy < best[0].Energy {
+			best[1] = best[0]
+			best[0] = hbondPartner{Residue: acceptor, Energy: energy}
+		} else if energy < best[1].Energy {
+			best[1] = hbondPartner{Residue: acceptor, Energy: energy}
+		}
+	}
+
+	for i := 0; i+1 < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if distance(s.residues[i].CA, s.residues[j].CA) >= hbondMaxCADistance {
+				continue
+			}
+			record(i, j)
+			if j != i+1 {
+				record(j, i) // The C=O of i and the N-H of i+1 belong to the same peptide bond
+			}
+		}
+	}
+}
+
+// hbond reports whether the C=O of acceptor is bonded to the N-H of donor
+func (s *dsspState) hbond(acceptor, donor int) bool {
+	if acceptor < 0 || donor < 0 || acceptor >= len(s.residues) || donor >= len(s.residues) {
+		return false
+	}
+	for _, partner := range s.donors[donor] {
+		if partner.Residue == acceptor && partner.Energy < hbondMaxEnergy {
+			return true
+		}
+	}
+	return false
+}
+
+// bends marks the residues where the chain direction changes by more than bendMinAngle
+func (s *dsspState) bends() []bool {
+	bends := make([]bool, len(s.residues))
+	for i := 2; i+2 < len(s.residues); i++ {
+		if !s.noBreak(i-2, i+2) {
+			continue
+		}
+		before := sub(s.residues[i].CA, s.residues[i-2].CA)
+		after := sub(s.residues[i+2].CA, s.residues[i].CA)
+		cosine := dot(before, after) / (norm(before) * norm(after))
+		kappa := math.Acos(math.Max(-1, math.Min(1, cosine))) * 180 / math.Pi
+		bends[i] = kappa > bendMinAngle
+	}
+	return bends
+}
+
+// assignFromHBonds()
+// Input: the number of residues, the hydrogen bond predicate hbond(acceptor, donor) (C=O of acceptor to N-H of
+// donor), noBreak(from, to) for chain continuity and the residues at bends
+// Output: the DSSP code of every residue
+// Separated from the geometry so the helix and ladder rules can be tested on chosen hydrogen bonds.
+func assignFromHBonds(n int, hbond func(acceptor, donor int) bool, noBreak func(from, to int) bool, bends []bool) []byte {
+	codes := make([]byte, n)
+	for i := range codes {
+		codes[i] = '-'
+	}
+
+	// Beta bridges and ladders
+	for _, l := range findLadders(n, hbond, noBreak) {
+		code := byte('B')
+		if l.Bridges > 1 {
+			code = 'E'
+		}
+		for i := l.IStart; i <= l.IEnd; i++ {
+			if codes[i] != 'E' {
+				codes[i] = code
+			}
+		}
+		for j := l.JStart; j <= l.JEnd; j++ {
+			if codes[j] != 'E' {
+				codes[j] = code
+			}
+		}
+	}
+
+	// n-turns: C=O(i) bonded to N-H(i+n)
+	turns := make(map[int][]bool)
+	for _, stride := range []int{3, 4, 5} {
+		turns[stride] = make([]bool, n)
+		for i := 0; i+stride < n; i++ {
+			turns[stride][i] = noBreak(i, i+stride) && hbond(i, i+stride)
+		}
+	}
+
+	// Alpha helices override everything; 3-10 and pi helices only fill residues that are still free
+	for i := 1; i+4 < n; i++ {
+		if turns[4][i] && turns[4][i-1] {
+			for j := i; j <= i+3; j++ {
+				codes[j] = 'H'
+			}
+		}
+	}
+	for _, helix := range []struct {
+		stride int
+		code   byte
+	}{{3, 'G'}, {5, 'I'}} {
+		for i := 1; i+helix.stride < n; i++ {
+			if !turns[helix.stride][i] || !turns[helix.stride][i-1] {
+				continue
+			}
+			free := true
+			for j := i; j < i+helix.stride; j++ {
+				free = free && (codes[j] == '-' || codes[j] == helix.code)
+			}
+			if free {
+				for j := i; j < i+helix.stride; j++ {
+					codes[j] = helix.code
+				}
+			}
+		}
+	}
+
+	// Turns cover the residues inside an n-turn; bends the remaining free residues
+	for i := 1; i+1 < n; i++ {
+		if codes[i] != '-' {
+			continue
+		}
+		isTurn := false
+		for _, stride := range []int{3, 4, 5} {
+			for k := 1; k < stride && !isTurn; k++ {
+				isTurn = i >= k && turns[stride][i-k]
+			}
+		}
+		if isTurn {
+			codes[i] = 'T'
+		} else if bends[i] {
+			codes[i] = 'S'
+		}
+	}
+	return codes
+}
+
+// testBridge returns the type of beta bridge between residues i and j, if any
+func testBridge(i, j int, hbond func(acceptor, donor int) bool, noBreak func(from, to int) bool) bridgeType {
+	if !noBreak(i-1, i+1) || !noBreak(j-1, j+1) {
+		return noBridge
+	}
+	if (hbond(i-1, j) && hbond(j, i+1)) || (hbond(j-1, i) && hbond(i, j+1)) {
+		return parallelBridge
+	}
+	if (hbond(i, j) && hbond(j, i)) || (hbond(i-1, j+1) && hbond(j-1, i+1)) {
+		return antiparallelBridge
+	}
+	return noBridge
+}
+
+// findLadders collects beta bridges into ladders of consecutive bridges, then joins ladders of the same
+// type separated by a beta bulge (a gap of at most 1 residue on one strand and 4 on the other)
+func findLadders(n int, hbond func(acceptor, donor int) bool, noBreak func(from, to int) bool) []*ladder {
+	var ladders []*ladder
+	for i := 1; i+4 < n; i++ {
+		for j := i + 3; j+1 < n; j++ {
+			kind := testBridge(i, j, hbond, noBreak)
+			if kind == noBridge {
+				continue
+			}
+
+			// Extend a ladder whose last bridge is (i-1, j-1) for parallel or (i-1, j+1) for antiparallel
+			extended := false
+			for _, l := range ladders {
+				if l.Type != kind || l.IEnd+1 != i {
+					continue
+				}
+				if (kind == parallelBridge && l.JEnd+1 == j) || (kind == antiparallelBridge && l.JStart-1 == j) {
+					l.Bridges++
+					l.IEnd = i
+					if kind == parallelBridge {
+						l.JEnd = j
+					} else {
+						l.JStart = j
+					}
+					extended = true
+					break
+				}
+			}
+			if !extended {
+				ladders = append(ladders, &ladder{Type: kind, Bridges: 1, IStart: i, IEnd: i, JStart: j, JEnd: j})
+			}
+		}
+	}
+
+	sort.SliceStable(ladders, func(a, b int) bool { return ladders[a].IStart < ladders[b].IStart })
+	for a := 0; a < len(ladders); a++ {
+		for b := a + 1; b < len(ladders); b++ {
+			first, second := ladders[a], ladders[b]
+			if first.Type != second.Type ||
+				!noBreak(Min(first.IStart, second.IStart), Max(first.IEnd, second.IEnd)) ||
+				!noBreak(Min(first.JStart, second.JStart), Max(first.JEnd, second.JEnd)) ||
+				second.IStart-first.IEnd >= 6 ||
+				(first.IEnd >= second.IStart && first.IStart <= second.IEnd) {
+				continue
+			}
+
+			var bulge bool
+			if first.Type == parallelBridge {
+				bulge = (second.JStart-first.JEnd < 6 && second.IStart-first.IEnd < 3) || second.JStart-first.JEnd < 3
+			} else {
+				bulge = (first.JStart-second.JEnd < 6 && second.IStart-first.IEnd < 3) || first.JStart-second.JEnd < 3
+			}
+			if !bulge {
+				continue
+			}
+
+			first.Bridges += second.Bridges
+			first.IStart, first.IEnd = Min(first.IStart, second.IStart), Max(first.IEnd, second.IEnd)
+			first.JStart, first.JEnd = Min(first.JStart, second.JStart), Max(first.JEnd, second.JEnd)
+			ladders = append(ladders[:b], ladders[b+1:]...)
+			b--
+		}
+	}
+	return ladders
+}
+
+// FormatCSV is the labeled dataset layout, an output format of WriteAssignments only
+const FormatCSV = "csv"
+
+// WriteAssignments()
+// Input: a writer, the output format (text, json, tsv or csv) and the assigned structures
+// Output: an error if the format is unknown, a structure has no standard residues, or writing fails
+// text, json and csv give one record per chain fragment, named and split at chain breaks and non-standard
+// residues as in DSSPProteins; tsv gives one row per residue with its chain and residue number.
+func WriteAssignments(w io.Writer, format string, structures []AssignedStructure) error {
+	if format == FormatTSV {
+		return writeTSVAssignments(w, structures)
+	}
+
+	var proteins []LabeledProtein
+	for _, structure := range structures {
+		chains, err := DSSPProteins(structure.Name, structure.Residues, "")
+		if err != nil {
+			return err
+		}
+		proteins = append(proteins, chains...)
+	}
+
+	switch format {
+	case FormatText:
+		var b strings.Builder
+		for _, protein := range proteins {
+			fmt.Fprintf(&b, ">%s\n%s\n%s\n", protein.Name, protein.Sequence, protein.Labels)
+		}
+		_, err := io.WriteString(w, b.String())
+		return err
+	case FormatJSON:
+		if proteins == nil {
+			proteins = []LabeledProtein{} // Encode as [] rather than null
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(proteins)
+	case FormatCSV:
+		writer := csv.NewWriter(w)
+		writer.Write([]string{datasetNameColumn, datasetSequenceColumn, datasetLabelColumn, "Length (n)"})
+		for _, protein := range proteins {
+			writer.Write([]string{protein.Name, protein.Sequence, protein.Labels, strconv.Itoa(len(protein.Sequence))})
+		}
+		writer.Flush()
+		return writer.Error()
+	default:
+		return fmt.Errorf("unknown output format %q (expected %s, %s, %s or %s)", format, FormatText, FormatJSON, FormatTSV, FormatCSV)
+	}
+}
+
+// writeTSVAssignments writes one tab-separated row per residue of the assigned structures
+func writeTSVAssignments(w io.Writer, structures []AssignedStructure) error {
+	var b strings.Builder
+	b.WriteString("protein\tchain\tnumber\tresidue\tdssp\n")
+	for _, structure := range structures {
+		for _, residue := range structure.Residues {
+			fmt.Fprintf(&b, "%s\t%s\t%s\t%c\t%c\n", structure.Name, residue.Chain, residue.Number, residue.AminoAcid, residue.Structure)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// sub returns a - b
+func sub(a, b Vector3) Vector3 {
+	return Vector3{a[0] - b[0], a[1] - b[1], a[2] - b[2]}
+}
+
+// add returns a + b
+func add(a, b Vector3) Vector3 {
+	return Vector3{a[0] + b[0], a[1] + b[1], a[2] + b[2]}
+}
+
+// scale returns v * f
+func scale(v Vector3, f float64) Vector3 {
+	return Vector3{v[0] * f, v[1] * f, v[2] * f}
+}
+
+// dot returns the scalar product of a and b
+func dot(a, b Vector3) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+// norm returns the length of v
+func norm(v Vector3) float64 {
+	return math.Sqrt(dot(v, v))
+}
+
+// distance returns the distance between two points
+func distance(a, b Vector3) float64 {
+	return norm(sub(a, b))
+}
@@ -0,0 +1,184 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// placeAtom returns the position of atom D bonded to C, given the bond length |CD|, the angle B-C-D and
+// the torsion A-B-C-D in degrees (natural extension reference frame)
+func placeAtom(a, b, c Vector3, length, angle, torsion float64) Vector3 {
+	angle, torsion = angle*math.Pi/180, torsion*math.Pi/180
+	bc := sub(c, b)
+	bc = scale(bc, 1/norm(bc))
+	ab := sub(b, a)
+	n := Vector3{ab[1]*bc[2] - ab[2]*bc[1], ab[2]*bc[0] - ab[0]*bc[2], ab[0]*bc[1] - ab[1]*bc[0]}
+	n = scale(n, 1/norm(n))
+	m := Vector3{n[1]*bc[2] - n[2]*bc[1], n[2]*bc[0] - n[0]*bc[2], n[0]*bc[1] - n[1]*bc[0]}
+	d := add(scale(bc, -length*math.Cos(angle)), add(scale(m, length*math.Sin(angle)*math.Cos(torsion)), scale(n, length*math.Sin(angle)*math.Sin(torsion))))
+	return add(c, d)
+}
+
+// idealBackbone builds a chain A of alanines with ideal bond geometry and the given phi/psi angles
+func idealBackbone(phi, psi []float64) []BackboneResidue {
+	residues := make([]BackboneResidue, len(phi))
+	n, ca := Vector3{0, 0, 0}, Vector3{1.458, 0, 0}
+	c := placeAtom(Vector3{0, 1, 0}, n, ca, 1.525, 111.2, phi[0])
+	for i := range phi {
+		if i > 0 {
+			prev := residues[i-1]
+			n = placeAtom(prev.N, prev.CA, prev.C, 1.329, 116.2, psi[i-1])
+			ca = placeAtom(prev.CA, prev.C, n, 1.458, 121.7, 180)
+			c = placeAtom(prev.C, n, ca, 1.525, 111.2, phi[i])
+		}
+		residues[i] = BackboneResidue{Chain: "A", Number: fmt.Sprint(i + 1), Name: "ALA", AminoAcid: 'A', N: n, CA: ca, C: c}
+		// The carbonyl O lies opposite the next N, so its torsion is psi + 180
+		residues[i].O = placeAtom(n, ca, c, 1.231, 120.5, psi[i]+180)
+	}
+	return residues
+}
+
+// backbonePDB writes backbone residues as PDB ATOM records
+func backbonePDB(residues []BackboneResidue) string {
+	var b strings.Builder
+	serial := 1
+	for _, r := range residues {
+		for _, atom := range []struct {
+			name string
+			pos  Vector3
+		}{{"N", r.N}, {"CA", r.CA}, {"C", r.C}, {"O", r.O}} {
+			fmt.Fprintf(&b, "ATOM  %5d  %-3s %3s %s%4s    %8.3f%8.3f%8.3f  1.00  0.00\n",
+				serial, atom.name, r.Name, r.Chain, r.Number, atom.pos[0], atom.pos[1], atom.pos[2])
+			serial++
+		}
+	}
+	b.WriteString("END\n")
+	return b.String()
+}
+
+// repeatAngle returns n copies of an angle
+func repeatAngle(angle float64, n int) []float64 {
+	angles := make([]float64, n)
+	for i := range angles {
+		angles[i] = angle
+	}
+	return angles
+}
+
+// structureCodes returns the assigned codes as a string
+func structureCodes(assigned []DSSPResidue) string {
+	codes := make([]byte, len(assigned))
+	for i, residue := range assigned {
+		codes[i] = residue.Structure
+	}
+	return string(codes)
+}
+
+func TestAssignAlphaHelix(t *testing.T) {
+	helix := idealBackbone(repeatAngle(-57, 14), repeatAngle(-47, 14))
+
+	// Round trip through the PDB format, as read from a file
+	residues, err := ParsePDB(strings.NewReader(backbonePDB(helix)))
+	if err != nil {
+		t.Fatalf("ParsePDB returned error: %v", err)
+	}
+	if len(residues) != 14 || residues[3].Number != "4" || residues[3].AminoAcid != 'A' {
+		t.Fatalf("Unexpected residues %+v", residues)
+	}
+
+	// The first and last residues only take part in one 4-turn each
+	codes := structureCodes(AssignSecondaryStructure(residues))
+	if codes != "-HHHHHHHHHHHH-" {
+		t.Errorf("Expected -HHHHHHHHHHHH-, got %s", codes)
+	}
+}
+
+func TestAssignExtendedChain(t *testing.T) {
+	// A single extended strand has no partner, so it has no hydrogen bonds and no helix, strand or turn
+	strand := idealBackbone(repeatAngle(-120, 10), repeatAngle(130, 10))
+	codes := structureCodes(AssignSecondaryStructure(strand))
+	if strings.ContainsAny(codes, "HGIEBT") {
+		t.Errorf("Expected no secondary structure, got %s", codes)
+	}
+}
+
+func TestAssignFromHBonds(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		bonds    [][2]int // C=O acceptor, N-H donor
+		bends    []int
+		expected string
+	}{
+		{"alpha helix", 12, [][2]int{{1, 5}, {2, 6}, {3, 7}, {4, 8}, {5, 9}}, nil, "--HHHHHHH---"},
+		{"3-10 helix and bend", 10, [][2]int{{2, 5}, {3, 6}}, []int{8}, "---GGG--S-"},
+		{"single turn", 8, [][2]int{{2, 6}}, nil, "---TTT--"},
+		{"antiparallel ladder", 15, [][2]int{{2, 12}, {12, 2}, {4, 10}, {10, 4}}, nil, "--EEE-----EEE--"},
+		{"parallel ladder", 16, [][2]int{{2, 10}, {10, 4}, {4, 12}, {12, 6}}, nil, "---EEE----EEE---"},
+		{"isolated bridge", 14, [][2]int{{3, 10}, {10, 3}}, nil, "---B------B---"},
+		// The 8-13 bond is also a 5-turn, which marks the hairpin loop
+		{"beta bulge", 23, [][2]int{{2, 20}, {20, 2}, {4, 18}, {18, 4}, {6, 15}, {15, 6}, {8, 13}, {13, 8}}, nil, "--EEEEEEETTTTEEEEEEEE--"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bonded := make(map[[2]int]bool)
+			for _, bond := range test.bonds {
+				bonded[bond] = true
+			}
+			hbond := func(acceptor, donor int) bool { return bonded[[2]int{acceptor, donor}] }
+			noBreak := func(from, to int) bool { return from >= 0 && to < test.n }
+			bends := make([]bool, test.n)
+			for _, i := range test.bends {
+				bends[i] = true
+			}
+
+			codes := string(assignFromHBonds(test.n, hbond, noBreak, bends))
+			if codes != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, codes)
+			}
+		})
+	}
+}
+
+func TestAssignCommand(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "helix.pdb")
+	helix := idealBackbone(repeatAngle(-57, 14), repeatAngle(-47, 14))
+	if err := os.WriteFile(file, []byte(backbonePDB(helix)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := RunCLI([]string{"assign", "-format", "csv", file}, &out); err != nil {
+		t.Fatalf("assign returned error: %v", err)
+	}
+	proteins, err := ParseLabeledDataset(&out)
+	if err != nil {
+		t.Fatalf("assign output is not a labeled dataset: %v", err)
+	}
+	if len(proteins) != 1 || proteins[0].Name != "helixA" || proteins[0].Sequence != "AAAAAAAAAAAAAA" || proteins[0].Labels != "-HHHHHHHHHHHH-" {
+		t.Errorf("Unexpected proteins %+v", proteins)
+	}
+
+	// Coordinate files are assigned when used as ground truth
+	out.Reset()
+	if err := RunCLI([]string{"evaluate", "-dssp", dir, "-format", "tsv"}, &out); err != nil {
+		t.Fatalf("evaluate returned error: %v", err)
+	}
+	if !strings.Contains(out.String(), "helixA\tGOR\t14\t") {
+		t.Errorf("Evaluation is missing the assigned protein:\n%s", out.String())
+	}
+
+	if err := RunCLI([]string{"assign", "-chain", "Z", file}, &out); err == nil || !strings.Contains(err.Error(), "chain Z not found") {
+		t.Errorf("Expected a missing chain error, got %v", err)
+	}
+}
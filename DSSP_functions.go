@@ -0,0 +1,325 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// standardResidues maps the three-letter names of the 20 standard amino acids to their one-letter codes
+var standardResidues = map[string]byte{
+	"ALA": 'A', "ARG": 'R', "ASN": 'N', "ASP": 'D', "CYS": 'C', "GLN": 'Q', "GLU": 'E', "GLY": 'G', "HIS": 'H', "ILE": 'I',
+	"LEU": 'L', "LYS": 'K', "MET": 'M', "PHE": 'F', "PRO": 'P', "SER": 'S', "THR": 'T', "TRP": 'W', "TYR": 'Y', "VAL": 'V',
+}
+
+// modifiedResidues maps common modified residues to the standard amino acid they are derived from
+var modifiedResidues = map[string]byte{
+	"MSE": 'M',                                                             // Selenomethionine
+	"SEP": 'S',                                                             // Phosphoserine
+	"TPO": 'T',                                                             // Phosphothreonine
+	"PTR": 'Y',                                                             // Phosphotyrosine
+	"CSO": 'C',                                                             // S-hydroxycysteine
+	"CME": 'C',                                                             // S,S-(2-hydroxyethyl)thiocysteine
+	"CSD": 'C',                                                             // 3-sulfinoalanine
+	"HYP": 'P',                                                             // 4-hydroxyproline
+	"MLY": 'K',                                                             // N-dimethyl-lysine
+	"KCX": 'K',                                                             // Lysine NZ-carboxylic acid
+	"LLP": 'K',                                                             // Lysine-pyridoxal-5'-phosphate
+	"PCA": 'E',                                                             // Pyroglutamic acid
+	"HSD": 'H', "HSE": 'H', "HSP": 'H', "HID": 'H', "HIE": 'H', "HIP": 'H', // Histidine protonation states
+}
+
+// dsspExtensions lists the file extensions read when a directory is given to LoadDSSPProteins
+var dsspExtensions = []string{".dssp", ".cif", ".mmcif", ".pdb", ".ent"}
+
+// residueCode()
+// Input: a three-letter residue name from a structure file
+// Output: its one-letter code (modified residues give their parent amino acid), or X if it is not an amino acid we know
+func residueCode(name string) byte {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if code, ok := standardResidues[name]; ok {
+		return code
+	}
+	if code, ok := modifiedResidues[name]; ok {
+		return code
+	}
+	return 'X'
+}
+
+// ParseDSSP()
+// Input: an io.Reader with a classic DSSP text file
+// Output: the residues in file order, or an error if the residue table is missing or malformed
+// Lines marked '!' are chain breaks: they are not residues, and the next residue has Break set.
+// Lowercase amino acid letters (cysteines in disulfide bridges) are reported as C.
+func ParseDSSP(r io.Reader) ([]DSSPResidue, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var residues []DSSPResidue
+	inTable := false
+	pendingBreak := false
+	lineNumber := 0
+	for scanner.Scan() {
+		line := scanner.Text()
+		lineNumber++
+		if !inTable {
+			// The residue table starts after the "  #  RESIDUE AA STRUCTURE ..." header
+			inTable = strings.HasPrefix(line, "  #  RESIDUE")
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if len(line) < 17 {
+			return nil, fmt.Errorf("line %d: residue line is too short", lineNumber)
+		}
+
+		aminoAcid := line[13]
+		if aminoAcid == '!' {
+			pendingBreak = true
+			continue
+		}
+		switch {
+		case aminoAcid >= 'a' && aminoAcid <= 'z':
+			aminoAcid = 'C' // Bridge partners of a disulfide are labeled a, b, c, ...
+		case strings.IndexByte("ACDEFGHIKLMNPQRSTVWY", aminoAcid) < 0:
+			aminoAcid = 'X'
+		}
+		structure := line[16]
+		if structure == ' ' {
+			structure = '-'
+		}
+
+		residues = append(residues, DSSPResidue{
+			Chain:     strings.TrimSpace(line[11:12]),
+			Number:    strings.TrimSpace(line[5:11]),
+			AminoAcid: aminoAcid,
+			Structure: structure,
+			Break:     pendingBreak,
+		})
+		pendingBreak = false
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !inTable {
+		return nil, fmt.Errorf("no DSSP residue table found (missing '  #  RESIDUE' header)")
+	}
+	return residues, nil
+}
+
+// ParseDSSPmmCIF()
+// Input: an io.Reader with mmCIF output of mkdssp
+// Output: the residues of the _dssp_struct_summary table, or an error if it is missing
+// Chains are identified by label_asym_id. mmCIF has no break records, so a gap in label_seq_id within
+// a chain is reported as a break.
+func ParseDSSPmmCIF(r io.Reader) ([]DSSPResidue, error) {
+	tables, err := ParseCIF(r)
+	if err != nil {
+		return nil, err
+	}
+	return dsspFromStructSummary(tables)
+}
+
+// dsspFromStructSummary reads the residues of the _dssp_struct_summary table of a parsed mmCIF file
+func dsspFromStructSummary(tables map[string]*CIFTable) ([]DSSPResidue, error) {
+	table, ok := tables["_dssp_struct_summary"]
+	if !ok {
+		return nil, fmt.Errorf("no _dssp_struct_summary table found (is this mkdssp output?)")
+	}
+
+	compColumn, chainColumn := table.Column("label_comp_id"), table.Column("label_asym_id")
+	seqColumn, structureColumn := table.Column("label_seq_id"), table.Column("secondary_structure")
+	if compColumn < 0 || chainColumn < 0 || seqColumn < 0 || structureColumn < 0 {
+		return nil, fmt.Errorf("_dssp_struct_summary needs label_comp_id, label_asym_id, label_seq_id and secondary_structure")
+	}
+
+	residues := make([]DSSPResidue, 0, len(table.Rows))
+	previousChain, previousNumber := "", 0
+	for _, row := range table.Rows {
+		structure := byte('-')
+		if value := row[structureColumn]; value != "." && value != "?" && value != "" {
+			structure = value[0]
+		}
+		chain := row[chainColumn]
+		number, err := strconv.Atoi(row[seqColumn])
+		if err != nil {
+			return nil, fmt.Errorf("invalid label_seq_id %q", row[seqColumn])
+		}
+
+		residues = append(residues, DSSPResidue{
+			Chain:     chain,
+			Number:    row[seqColumn],
+			AminoAcid: residueCode(row[compColumn]),
+			Structure: structure,
+			Break:     chain == previousChain && number != previousNumber+1,
+		})
+		previousChain, previousNumber = chain, number
+	}
+	return residues, nil
+}
+
+// ReadDSSPFile()
+// Input: the path of a classic DSSP file, an mkdssp mmCIF file, or a PDB or mmCIF coordinate file
+// Output: the residues in file order, or an error
+// The format is detected from the content: mmCIF files start with a data_ block, and are read as mkdssp
+// output if they have a _dssp_struct_summary table. Coordinate files (PDB ATOM records, or mmCIF with
+// only _atom_site) are assigned with AssignSecondaryStructure, so no DSSP binary is needed.
+func ReadDSSPFile(filename string) ([]DSSPResidue, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read DSSP file %s: %v", filename, err)
+	}
+
+	var residues []DSSPResidue
+	switch {
+	case bytes.HasPrefix(bytes.TrimSpace(content), []byte("data_")):
+		var tables map[string]*CIFTable
+		tables, err = ParseCIF(bytes.NewReader(content))
+		if err != nil {
+			break
+		}
+		if _, ok := tables["_dssp_struct_summary"]; !ok && tables["_atom_site"] != nil {
+			var backbone []BackboneResidue
+			if backbone, err = backboneFromAtomSite(tables); err == nil {
+				residues = AssignSecondaryStructure(backbone)
+			}
+			break
+		}
+		residues, err = dsspFromStructSummary(tables)
+	case isPDBContent(content):
+		var backbone []BackboneResidue
+		if backbone, err = ParsePDB(bytes.NewReader(content)); err == nil {
+			residues = AssignSecondaryStructure(backbone)
+		}
+	default:
+		residues, err = ParseDSSP(bytes.NewReader(content))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return residues, nil
+}
+
+// isPDBContent reports whether a file holds PDB atom records rather than a classic DSSP table
+func isPDBContent(content []byte) bool {
+	if bytes.Contains(content, []byte("  #  RESIDUE")) {
+		return false
+	}
+	for _, line := range bytes.Split(content, []byte("\n")) {
+		if bytes.HasPrefix(line, []byte("ATOM  ")) || bytes.HasPrefix(line, []byte("HETATM")) {
+			return true
+		}
+	}
+	return false
+}
+
+// DSSPProteins()
+// Input: a name for the structure, its DSSP residues and the chain to extract ("" for every chain)
+// Output: one LabeledProtein per continuous fragment of each chain, with the DSSP codes as labels (- for no
+// structure), or an error if the chain is not present
+// A chain is split at every chain break and at every residue that is not one of the 20 standard amino acids,
+// which is left out, so no two residues become neighbors that are not bonded in the structure. A chain kept
+// whole is named <name><chain>; the fragments of a split chain are named <name><chain>_1, <name><chain>_2, ...
+func DSSPProteins(name string, residues []DSSPResidue, chain string) ([]LabeledProtein, error) {
+	type fragment struct{ sequence, labels []byte }
+	var chains []string
+	fragments := make(map[string][]fragment)
+	split := make(map[string]bool) // Whether the next standard residue of the chain starts a new fragment
+	for _, residue := range residues {
+		if chain != "" && residue.Chain != chain {
+			continue
+		}
+		c := residue.Chain
+		if _, seen := split[c]; !seen {
+			chains = append(chains, c)
+			split[c] = true
+		}
+		if residue.AminoAcid == 'X' {
+			split[c] = true // Non-standard residue the predictors cannot score
+			continue
+		}
+		if split[c] || residue.Break {
+			fragments[c] = append(fragments[c], fragment{})
+			split[c] = false
+		}
+		last := &fragments[c][len(fragments[c])-1]
+		last.sequence = append(last.sequence, residue.AminoAcid)
+		last.labels = append(last.labels, residue.Structure)
+	}
+	if len(chains) == 0 {
+		if chain != "" {
+			return nil, fmt.Errorf("%s: chain %s not found", name, chain)
+		}
+		return nil, fmt.Errorf("%s: no residues found", name)
+	}
+
+	var proteins []LabeledProtein
+	for _, c := range chains {
+		for i, f := range fragments[c] {
+			fragmentName := name + c
+			if len(fragments[c]) > 1 {
+				fragmentName += "_" + strconv.Itoa(i+1)
+			}
+			proteins = append(proteins, LabeledProtein{Name: fragmentName, Sequence: string(f.sequence), Labels: string(f.labels)})
+		}
+	}
+	return proteins, nil
+}
+
+// LoadDSSPProteins()
+// Input: paths of DSSP files (classic or mmCIF), PDB or mmCIF coordinate files, or directories holding them,
+// and the chain to extract ("" for all)
+// Output: the labeled proteins of every file, named after the file and chain, or an error
+func LoadDSSPProteins(paths []string, chain string) ([]LabeledProtein, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			ext := strings.ToLower(filepath.Ext(entry.Name()))
+			for _, wanted := range dsspExtensions {
+				if !entry.IsDir() && ext == wanted {
+					files = append(files, filepath.Join(path, entry.Name()))
+				}
+			}
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no DSSP or structure files found in %s", strings.Join(paths, ", "))
+	}
+
+	var proteins []LabeledProtein
+	for _, file := range files {
+		residues, err := ReadDSSPFile(file)
+		if err != nil {
+			return nil, err
+		}
+		name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		fileProteins, err := DSSPProteins(name, residues, chain)
+		if err != nil {
+			return nil, err
+		}
+		proteins = append(proteins, fileProteins...)
+	}
+	return proteins, nil
+}
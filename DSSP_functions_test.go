@@ -0,0 +1,182 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// dsspTail is the part of a classic DSSP residue line after the structure columns, which the parser ignores
+const dsspTail = "   0   0  148      0, 0.0     2,-0.3     0, 0.0     0, 0.0   0.000 360.0 360.0 360.0 -68.3   14.2   32.4   -3.1"
+
+// classicDSSP builds a classic DSSP file from "chain aa ss" triples; "!" is a chain break
+func classicDSSP(residues ...string) string {
+	var b strings.Builder
+	b.WriteString("==== Secondary Structure Definition by the program DSSP ====\n")
+	b.WriteString("HEADER    TEST PROTEIN\n")
+	b.WriteString("  #  RESIDUE AA STRUCTURE BP1 BP2  ACC     N-H-->O    O-->H-N    N-H-->O    O-->H-N    TCO  KAPPA ALPHA  PHI   PSI    X-CA   Y-CA   Z-CA\n")
+	for i, residue := range residues {
+		if residue == "!" {
+			fmt.Fprintf(&b, "%5d        !           %s\n", i+1, dsspTail)
+			continue
+		}
+		fmt.Fprintf(&b, "%5d%5d %c %c  %c        %s\n", i+1, i+1, residue[0], residue[1], residue[2], dsspTail)
+	}
+	return b.String()
+}
+
+func TestParseDSSP(t *testing.T) {
+	content := classicDSSP("AMH", "AaH", "AX ", "!", "AKE", "BGT", "BLS")
+	residues, err := ParseDSSP(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []DSSPResidue{
+		{Chain: "A", Number: "1", AminoAcid: 'M', Structure: 'H'},
+		{Chain: "A", Number: "2", AminoAcid: 'C', Structure: 'H'}, // Disulfide cysteine
+		{Chain: "A", Number: "3", AminoAcid: 'X', Structure: '-'},
+		{Chain: "A", Number: "5", AminoAcid: 'K', Structure: 'E', Break: true},
+		{Chain: "B", Number: "6", AminoAcid: 'G', Structure: 'T'},
+		{Chain: "B", Number: "7", AminoAcid: 'L', Structure: 'S'},
+	}
+	if !reflect.DeepEqual(residues, expected) {
+		t.Errorf("Expected %+v but got %+v", expected, residues)
+	}
+
+	if _, err := ParseDSSP(strings.NewReader("not a DSSP file\n")); err == nil {
+		t.Errorf("Expected an error for a file without a residue table")
+	}
+}
+
+// mkdsspCIF is a shortened mmCIF file written by mkdssp
+const mkdsspCIF = `data_TEST
+#
+loop_
+_dssp_struct_summary.entry_id
+_dssp_struct_summary.label_comp_id
+_dssp_struct_summary.label_asym_id
+_dssp_struct_summary.label_seq_id
+_dssp_struct_summary.secondary_structure
+_dssp_struct_summary.ss_bridge
+TEST MET A 1 . .
+TEST ALA A 2 H .
+TEST MSE A 3 H .
+TEST UNK A 5 G .
+TEST VAL B 1 E .
+TEST SER B 2 P .
+#
+`
+
+func TestParseDSSPmmCIF(t *testing.T) {
+	residues, err := ParseDSSPmmCIF(strings.NewReader(mkdsspCIF))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []DSSPResidue{
+		{Chain: "A", Number: "1", AminoAcid: 'M', Structure: '-'},
+		{Chain: "A", Number: "2", AminoAcid: 'A', Structure: 'H'},
+		{Chain: "A", Number: "3", AminoAcid: 'M', Structure: 'H'}, // Selenomethionine
+		{Chain: "A", Number: "5", AminoAcid: 'X', Structure: 'G', Break: true},
+		{Chain: "B", Number: "1", AminoAcid: 'V', Structure: 'E'},
+		{Chain: "B", Number: "2", AminoAcid: 'S', Structure: 'P'},
+	}
+	if !reflect.DeepEqual(residues, expected) {
+		t.Errorf("Expected %+v but got %+v", expected, residues)
+	}
+
+	if _, err := ParseDSSPmmCIF(strings.NewReader("data_X\n_entry.id X\n")); err == nil {
+		t.Errorf("Expected an error without a _dssp_struct_summary table")
+	}
+}
+
+func TestDSSPProteins(t *testing.T) {
+	residues, err := ParseDSSP(strings.NewReader(classicDSSP("AMH", "AaH", "AX ", "!", "AKE", "BGT", "BLS")))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	proteins, err := DSSPProteins("1abc", residues, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []LabeledProtein{
+		{Name: "1abcA_1", Sequence: "MC", Labels: "HH"}, // The unknown residue is left out
+		{Name: "1abcA_2", Sequence: "K", Labels: "E"},
+		{Name: "1abcB", Sequence: "GL", Labels: "TS"},
+	}
+	if !reflect.DeepEqual(proteins, expected) {
+		t.Errorf("Expected %+v but got %+v", expected, proteins)
+	}
+
+	// A chain break splits the chain even when every residue is standard, as does a non-standard residue
+	for _, tt := range []struct {
+		residues []string // Residues as given to classicDSSP
+		expected []LabeledProtein
+	}{
+		{
+			residues: []string{"AMH", "AEH", "!", "ALE", "AVE"},
+			expected: []LabeledProtein{{Name: "1abcA_1", Sequence: "ME", Labels: "HH"}, {Name: "1abcA_2", Sequence: "LV", Labels: "EE"}},
+		},
+		{
+			residues: []string{"AMH", "AX ", "AEH", "ALH"},
+			expected: []LabeledProtein{{Name: "1abcA_1", Sequence: "M", Labels: "H"}, {Name: "1abcA_2", Sequence: "EL", Labels: "HH"}},
+		},
+		{
+			residues: []string{"AX ", "AMH", "AEH", "AX "},
+			expected: []LabeledProtein{{Name: "1abcA", Sequence: "ME", Labels: "HH"}},
+		},
+	} {
+		residues, err := ParseDSSP(strings.NewReader(classicDSSP(tt.residues...)))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if proteins, err := DSSPProteins("1abc", residues, ""); err != nil || !reflect.DeepEqual(proteins, tt.expected) {
+			t.Errorf("%v: expected %+v but got %+v (%v)", tt.residues, tt.expected, proteins, err)
+		}
+	}
+
+	if proteins, err := DSSPProteins("1abc", residues, "B"); err != nil || len(proteins) != 1 || proteins[0].Name != "1abcB" {
+		t.Errorf("Expected only chain B, got %+v (%v)", proteins, err)
+	}
+	if _, err := DSSPProteins("1abc", residues, "Z"); err == nil {
+		t.Errorf("Expected an error for a missing chain")
+	}
+}
+
+func TestEvaluateDSSPFiles(t *testing.T) {
+	dir := t.TempDir()
+	classic := classicDSSP("AMH", "AEH", "ALH", "AKH", "ALH", "AGT", "AVE", "AVE", "AIE", "AS ")
+	if err := os.WriteFile(filepath.Join(dir, "1abc.dssp"), []byte(classic), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "2xyz.cif"), []byte(mkdsspCIF), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	proteins, err := LoadDSSPProteins([]string{dir}, "A")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(proteins) != 2 || proteins[0].Name != "1abcA" || proteins[1].Name != "2xyzA" {
+		t.Fatalf("Unexpected proteins %+v", proteins)
+	}
+
+	var out bytes.Buffer
+	if err := RunCLI([]string{"evaluate", "-dssp", dir, "-chain", "A", "-reduce", "hec", "-format", "tsv"}, &out); err != nil {
+		t.Fatalf("evaluate returned error: %v", err)
+	}
+	if !strings.Contains(out.String(), "1abcA\tGOR\t10\t") {
+		t.Errorf("Evaluation is missing the DSSP protein:\n%s", out.String())
+	}
+}
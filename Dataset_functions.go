@@ -0,0 +1,91 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Column names of the labeled dataset layout used by AccuracyTestDataset_50.csv
+const (
+	datasetNameColumn     = "ProteinName"
+	datasetSequenceColumn = "ProteinSequence"
+	datasetLabelColumn    = "DSSPSequence"
+)
+
+// ReadLabeledDataset()
+// Input: filename of a CSV file with ProteinName, ProteinSequence and DSSPSequence columns (other columns are ignored)
+// Output: a slice of LabeledProtein values in file order, or an error if the file or header is malformed
+func ReadLabeledDataset(filename string) ([]LabeledProtein, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	proteins, err := ParseLabeledDataset(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return proteins, nil
+}
+
+// ParseLabeledDataset()
+// Input: an io.Reader with the CSV contents described in ReadLabeledDataset
+// Output: a slice of LabeledProtein values, or an error
+// Whitespace inside the sequence and label columns is removed and sequences are upper-cased.
+func ParseLabeledDataset(r io.Reader) ([]LabeledProtein, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1 // Tolerate ragged trailing columns
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %v", err)
+	}
+
+	// Locate the required columns by name
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.TrimPrefix(strings.TrimSpace(name), "\ufeff") // Excel writes a byte-order mark
+		columns[name] = i
+	}
+	for _, name := range []string{datasetNameColumn, datasetSequenceColumn, datasetLabelColumn} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing required column %s", name)
+		}
+	}
+
+	var proteins []LabeledProtein
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break // End of file reached
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read record: %v", err)
+		}
+
+		field := func(name string) string {
+			idx := columns[name]
+			if idx >= len(record) {
+				return ""
+			}
+			return record[idx]
+		}
+
+		proteins = append(proteins, LabeledProtein{
+			Name:     strings.TrimSpace(field(datasetNameColumn)),
+			Sequence: strings.ToUpper(strings.Join(strings.Fields(field(datasetSequenceColumn)), "")),
+			Labels:   strings.Join(strings.Fields(field(datasetLabelColumn)), ""),
+		})
+	}
+
+	return proteins, nil
+}
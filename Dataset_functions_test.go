@@ -0,0 +1,48 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseLabeledDataset(t *testing.T) {
+	input := "\ufeffProteinName,ProteinSequence,DSSPSequence,Length (n)\n" +
+		"P1,acde,CHHC,4\n" +
+		"P2, GG VV ,CCEE,4\n"
+	expected := []LabeledProtein{
+		{Name: "P1", Sequence: "ACDE", Labels: "CHHC"},
+		{Name: "P2", Sequence: "GGVV", Labels: "CCEE"},
+	}
+
+	result, err := ParseLabeledDataset(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseLabeledDataset returned error: %v", err)
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+
+	// A missing required column is an error
+	if _, err := ParseLabeledDataset(strings.NewReader("ProteinName,ProteinSequence\nP1,AC\n")); err == nil {
+		t.Errorf("Expected an error for a missing DSSPSequence column")
+	}
+}
+
+func TestReadLabeledDatasetAccuracyFile(t *testing.T) {
+	proteins, err := ReadLabeledDataset("AccuracyTestDataset_50.csv")
+	if err != nil {
+		t.Fatalf("ReadLabeledDataset returned error: %v", err)
+	}
+	if len(proteins) != 50 {
+		t.Errorf("Expected 50 proteins but got %d", len(proteins))
+	}
+	for _, protein := range proteins {
+		if len(protein.Sequence) != len(protein.Labels) {
+			t.Errorf("Protein %s: sequence length %d does not match label length %d", protein.Name, len(protein.Sequence), len(protein.Labels))
+		}
+	}
+}
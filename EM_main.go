@@ -4,7 +4,8 @@
 package main
 
 import (
-	"log"
+	"fmt"
+	"math"
 )
 
 // Create a New HMM
@@ -36,210 +37,324 @@ func NewHMM1(states, symbols []string) *HMM {
 	return hmm
 }
 
-// Train the HMM using the EM Algorithm
-func (hmm *HMM) TrainEM(sequences []string, labels []string) {
-	if len(sequences) != len(labels) {
-		log.Fatalf("Number of sequences and labels must match")
+// TrainSupervised estimates the HMM parameters by counting initial states, transitions and emissions in
+// labeled proteins. Each count starts at the pseudocount from options (a symmetric Dirichlet prior;
+// 1 gives Laplace smoothing), so residues and transitions never seen in training keep a non-zero probability.
+// A protein whose sequence and label lengths differ, or that contains a residue or label the HMM does not
+// know, is reported as an error and the parameters are left unchanged.
+func (hmm *HMM) TrainSupervised(proteins []LabeledProtein, options SupervisedOptions) error {
+	if options.InitialPseudocount < 0 || options.TransitionPseudocount < 0 || options.EmissionPseudocount < 0 {
+		return fmt.Errorf("pseudocounts must not be negative")
 	}
 
 	numStates := len(hmm.States)
 	numSymbols := len(hmm.Symbols)
-	numSequences := len(sequences)
 
-	// Initialize counts
+	// Initialize counts with the pseudocounts
 	initialCounts := make([]float64, numStates)
 	transitionCounts := make([][]float64, numStates)
 	emissionCounts := make([][]float64, numStates)
-
 	for i := 0; i < numStates; i++ {
+		initialCounts[i] = options.InitialPseudocount
 		transitionCounts[i] = make([]float64, numStates)
 		emissionCounts[i] = make([]float64, numSymbols)
+		for j := range transitionCounts[i] {
+			transitionCounts[i][j] = options.TransitionPseudocount
+		}
+		for k := range emissionCounts[i] {
+			emissionCounts[i][k] = options.EmissionPseudocount
+		}
 	}
 
 	// Count occurrences from labeled data
-	for idx := 0; idx < numSequences; idx++ {
-		sequence := sequences[idx]
-		label := labels[idx]
-
-		// Count initial state
-		startState := hmm.StateMapping[string(label[0])]
-		initialCounts[startState]++
-
-		// Count transitions and emissions
-		for t := 0; t < len(sequence); t++ {
-			currentState := hmm.StateMapping[string(label[t])]
-			currentSymbol := hmm.SymbolMapping[string(sequence[t])]
-
-			// Count emission
-			emissionCounts[currentState][currentSymbol]++
-
-			// Count transitions if not at last symbol
-			if t < len(sequence)-1 {
-				nextState := hmm.StateMapping[string(label[t+1])]
-				transitionCounts[currentState][nextState]++
+	trained := 0
+	for _, protein := range proteins {
+		if len(protein.Sequence) != len(protein.Labels) {
+			return fmt.Errorf("%s: sequence has %d residues but %d labels", protein.Name, len(protein.Sequence), len(protein.Labels))
+		}
+		if len(protein.Sequence) == 0 {
+			continue // Nothing to count
+		}
+
+		states := make([]int, len(protein.Labels))
+		for t := range protein.Labels {
+			state, ok := hmm.StateMapping[string(protein.Labels[t])]
+			if !ok {
+				return fmt.Errorf("%s: unknown label %s at position %d", protein.Name, string(protein.Labels[t]), t+1)
 			}
+			states[t] = state
 		}
+		obs, err := hmm.observationIndices(protein.Sequence)
+		if err != nil {
+			return fmt.Errorf("%s: %v", protein.Name, err)
+		}
+
+		// Count initial state, transitions and emissions
+		initialCounts[states[0]]++
+		for t := range obs {
+			emissionCounts[states[t]][obs[t]]++
+			if t < len(obs)-1 {
+				transitionCounts[states[t]][states[t+1]]++
+			}
+		}
+		trained++
+	}
+	if trained == 0 {
+		return fmt.Errorf("no non-empty labeled sequences to train on")
 	}
 
-	// Update Initial Probabilities
+	// Normalize the counts, falling back to a uniform distribution for a state that was never seen
+	uniformStates := uniformDistribution(numStates)
+	uniformSymbols := uniformDistribution(numSymbols)
+	transition := make([][]float64, numStates)
+	emission := make([][]float64, numStates)
 	for i := 0; i < numStates; i++ {
-		hmm.Initial[i] = initialCounts[i] / float64(numSequences)
+		transition[i] = normalizeCounts(transitionCounts[i], uniformStates)
+		emission[i] = normalizeCounts(emissionCounts[i], uniformSymbols)
 	}
 
-	// Update Transition Probabilities
-	for i := 0; i < numStates; i++ {
-		totalTransitions := 0.0
-		for j := 0; j < numStates; j++ {
-			totalTransitions += transitionCounts[i][j]
+	// The HMM stores its parameters in log space
+	hmm.LoadProbabilities(normalizeCounts(initialCounts, uniformStates), transition, emission)
+	return nil
+}
+
+// uniformDistribution returns n equal probabilities summing to 1
+func uniformDistribution(n int) []float64 {
+	probs := make([]float64, n)
+	for i := range probs {
+		probs[i] = 1.0 / float64(n)
+	}
+	return probs
+}
+
+// BaumWelch refines the HMM parameters from unlabeled sequences with Baum-Welch re-estimation.
+// Each iteration runs forward-backward on every sequence under the current parameters, then replaces
+// the initial, transition and emission probabilities with the normalized expected counts (plus
+// options.Pseudocount). Training stops when the total log-likelihood improves by less than
+// options.Tolerance or after options.MaxIterations iterations. The current parameters are the
+// starting point, so the HMM should be initialized (e.g. with TrainSupervised) before calling BaumWelch.
+func (hmm *HMM) BaumWelch(sequences []string, options BaumWelchOptions) (BaumWelchResult, error) {
+	var result BaumWelchResult
+	if options.MaxIterations <= 0 {
+		return result, fmt.Errorf("maximum number of iterations must be positive, got %d", options.MaxIterations)
+	}
+	if options.Tolerance < 0 || options.Pseudocount < 0 {
+		return result, fmt.Errorf("tolerance and pseudocount must not be negative")
+	}
+
+	// Convert every sequence up front so an unknown symbol is reported before any work is done
+	var observations [][]int
+	for idx, sequence := range sequences {
+		obs, err := hmm.observationIndices(sequence)
+		if err != nil {
+			return result, fmt.Errorf("sequence %d: %v", idx+1, err)
 		}
-		for j := 0; j < numStates; j++ {
-			if totalTransitions > 0 {
-				hmm.Transition[i][j] = transitionCounts[i][j] / totalTransitions
-			} else {
-				hmm.Transition[i][j] = 1.0 / float64(numStates) // Handle zero case
-			}
+		if len(obs) > 0 {
+			observations = append(observations, obs)
 		}
 	}
+	if len(observations) == 0 {
+		return result, fmt.Errorf("no non-empty sequences to train on")
+	}
 
-	// Update Emission Probabilities
-	for i := 0; i < numStates; i++ {
-		totalEmissions := 0.0
-		for k := 0; k < numSymbols; k++ {
-			totalEmissions += emissionCounts[i][k]
+	numStates := len(hmm.States)
+	numSymbols := len(hmm.Symbols)
+
+	for iteration := 1; iteration <= options.MaxIterations; iteration++ {
+		initial, transition, emission := hmm.Probabilities()
+
+		// Expected counts, started at the pseudocount
+		initialCounts := make([]float64, numStates)
+		transitionCounts := make([][]float64, numStates)
+		emissionCounts := make([][]float64, numStates)
+		for i := 0; i < numStates; i++ {
+			initialCounts[i] = options.Pseudocount
+			transitionCounts[i] = make([]float64, numStates)
+			emissionCounts[i] = make([]float64, numSymbols)
+			for j := range transitionCounts[i] {
+				transitionCounts[i][j] = options.Pseudocount
+			}
+			for k := range emissionCounts[i] {
+				emissionCounts[i][k] = options.Pseudocount
+			}
 		}
-		for k := 0; k < numSymbols; k++ {
-			if totalEmissions > 0 {
-				hmm.Emission[i][k] = emissionCounts[i][k] / totalEmissions
-			} else {
-				hmm.Emission[i][k] = 1.0 / float64(numSymbols) // Handle zero case
+
+		// E-step: accumulate expected counts with forward-backward
+		logLikelihood := 0.0
+		for idx, obs := range observations {
+			alpha, scales, err := hmm.scaledForward(obs, initial, transition, emission)
+			if err != nil {
+				return result, fmt.Errorf("iteration %d, sequence %d: %v", iteration, idx+1, err)
 			}
+			beta := hmm.scaledBackward(obs, scales, transition, emission)
+
+			for t := range obs {
+				logLikelihood += math.Log(scales[t])
+				for i := 0; i < numStates; i++ {
+					gamma := alpha[t][i] * beta[t][i] // Posterior of state i at position t
+					if t == 0 {
+						initialCounts[i] += gamma
+					}
+					emissionCounts[i][obs[t]] += gamma
+
+					// Expected number of i -> j transitions between t and t+1
+					if t < len(obs)-1 {
+						for j := 0; j < numStates; j++ {
+							transitionCounts[i][j] += alpha[t][i] * transition[i][j] * emission[j][obs[t+1]] * beta[t+1][j] / scales[t+1]
+						}
+					}
+				}
+			}
+		}
+
+		result.LogLikelihoods = append(result.LogLikelihoods, logLikelihood)
+		result.Iterations = iteration
+		if options.Progress != nil {
+			options.Progress(iteration, logLikelihood)
+		}
+
+		// M-step: normalize the expected counts into probabilities
+		hmm.LoadProbabilities(
+			normalizeCounts(initialCounts, initial),
+			normalizeCountRows(transitionCounts, transition),
+			normalizeCountRows(emissionCounts, emission),
+		)
+
+		// Stop once the log-likelihood no longer improves by more than the tolerance
+		if iteration > 1 && math.Abs(logLikelihood-result.LogLikelihoods[iteration-2]) < options.Tolerance {
+			result.Converged = true
+			break
 		}
 	}
+
+	return result, nil
+}
+
+// normalizeCounts divides counts by their sum. If every count is zero, the previous probabilities are kept.
+func normalizeCounts(counts, previous []float64) []float64 {
+	total := 0.0
+	for _, c := range counts {
+		total += c
+	}
+	if total == 0 {
+		return append([]float64{}, previous...)
+	}
+	probs := make([]float64, len(counts))
+	for i, c := range counts {
+		probs[i] = c / total
+	}
+	return probs
 }
 
-/* Commented out - uncomment when training model to get the parameters
-func main() {
-	// Define HMM States and Symbols
-	states := []string{"H", "E", "C", "T"}
-	symbols := []string{"A", "C", "D", "E", "F", "G", "H", "I", "K", "L", "M", "N", "P", "Q", "R", "S", "T", "V", "W", "Y"}
-
-	// Initialize HMM
-	hmm := NewHMM1(states, symbols)
-
-	// Example Sequences and Labels
-	sequences := []string{
-		"ETGTVPAINYLGAGYDHVRGNPVGDPSSMGDPGIRPPVLRFTYAQNEDGVSNDLTVLQPLGGYVRQYVACRQSETISELSNLSDYQNELSVDASLQGGDPIGLNSFSASTGYRDFAKEVSKKDTRTYMLKNYCMRYEAGVAQSNHFKWNVTLAFAAGVSQLPDVFDAHNPECACSAEQWRQDQNAEACTKTNVPIWISFIEQFGTHFLVRLFAGGKMTYQVTAKRSEVEKMRNMGIDVKTQLKMQLGGVSGGAGQGTSSKKQQSSSEYQMNVQKETLVIGGRPPGQVSDPAALAAWADTVEELPMPVKFEVQPLYHLLPVEKQEAFKQAVTFYSKAVGLTPQDLSALGTKHHHHHH",
-		"MGSSHHHHHHSQDPNSISTTMSSYSIQQSQKMLTQLQIDYATNTSSNTVVAYLHNVGETTISYLQNSVVYFGPNGQLQPVGYNSGSSPYWTVTSNSLQPGSVVKIIIYLSSPLSSNQYYTIQIVTPNGYTVSYMF",
-		"KKPGVGTYATVDKLKAFDVTDGKKDAFTIKDTVRLYNVEEGKTYAIAGQLYEQSVAGDEGSALAKAATTVKVTASMAKPATEVEKTKYGEDVKVYETEMDLTVKREDLTKNQVVKDDIALVVYEQLWAEGTYEKVNDTEVTPKGKSEPVAKHNDPQSSSQSITAEPQFGSLKLTKTVTGWEDAFAKVARPEASYKFTVKCVQKGSVDEFTLKEGEEKTVEGIPLGDTCTISEDVQGAVNQAGLKDTVKFTAVNGVTVDSQVNGEAVVKIGGTANGSDTVANVEVTAENSFSY",
-		"MSSVLVLGRISDDPASHYEQLKPLLDYVVPRMREVGIRRGEILMAPDARQMSSYLRRGRVDWVSETTGAAMLLEQRGSAHPLLMTERGGLRDFHTLFFVRRDSPIHSLSQLRGHTLALQNASSTSGYLLPMLELLRNGIACDVLLSADDTPARGSAGYLMVGSKLNVAAFVHKHLIDVGALSNVDWDDERHMPPVFKRDFRIVHRTAPVPRAVEMVRTGMDPAVEQRLRVVLLQAASDPKAGPALKRFFDTTGFRPLDPTSRRRLQELSAGVQRVRDHVE",
-		"SLLLDDVDNEMAAIAMQGFRSMIEQFNVNNPATAKELQAMEAQLTAMSDQLVGADGELPAEIQAIKDALAQALKQPSADGLATAMGQVAFAAAKVGGGSAGTAGTVQMNVKQLYKTAFSSTSSSSYAAALSDGYSAYKTLNSLYSESRSGVQSAISQTANPALSRSVSRSGIESQGRSADASQRAAETIVRDSQTLGDVYSRLQVLDSLMSTIVSNPQANQEEIMQKLTASISKAPQFGYPAVQNSVDSLQKFAAQLEREFVDGERSLAESQENAFRKQPAFIQQVLVNIASLFSGYLS",
-		"GSMKQIYDTLQLIPVDKIDLHEAFEPSRLEKTKESIAKEQHLRHPVLVVKTLFGRYMVIDGVHRFMSLKALGCEVIPVQVIQRTQYSIGSWHHKIPNGAWCEGLTDEELLPWTTEVRDETPFITMCDQQTEHYLYVADLTVDKLDIWKKVVNSYSASCNVERVPHSACLCLDSNDILMKYQPLQIGEIEAVVQRGQTVPAGVTRFNIAGRCLNLQVPLHLLKNSNLGNQEQWHTFLQKKIESMRCYTEKIYLIEAE",
-		"AHMAPLSSDELKTVVSVLAQKLDSLNIDYAIMGGAATCLLSGDPNRRTEDVDLVIHVDHRKITADNLTTQLLKSFPSDFEGVSQFGHTIPAYKLRRPGGTVQLVELEVFDYQSWPQRPQYDLQTATRTTLNINGQKVKLFSPEWILREKILSQYQRQGSRKEGTDIRDIISMIPLAVPGKPELNFNQSQELQTALANLVQKRPDLSSALKAKIKCSAVFHN",
-		"SNAMKISDAVVSAHIDDEVVLLHLQTGTYFGLDAVGSRIWSLLEEGKRPEEIVDAICAEYSVDRPTVERDLRDFLRALANKELLEGYADEA",
-		"MDKNWFSTPQEIREGIKYLSAHFYPASIMDRWKILKKLSFEKAKIIANYSLQQVIEEIEHFDFFNEYFKEDPLTTVRLPPSYIKLFDGLVEDFQSSRWRENIATRFHMITEGVLATVGLKILNETSRKYNLLKFNEGIKRIIEDEARHVSFGLSLIEDKEYAVKRVEELFPLAVQIVKEGKDKIEPLGYSIQELVNLMEELKKARINKILGSGSHHHHHH",
-		"QKEYMEYRPLGEEIERIRKGKNIPLRVFDENGVSSRSYQRFVQGNSELRISDLAIIVEILSISPMEMTEKLTPMSKTVLAKEQFNQAIFSKNFQESSRIVADYRAYYEKSSFALGKQEVMYSMLALEYLFNPQTVVTKEEIIALENQILERLINADVYTIFNLKFLALQKNVGLQPFPTSLLFRVLQSVNEREIIDIRSLEIIEQVIIDFLFAAIVSQNVPHILHVLSMFKEYEVGENNWRMILWKKIAEKIEMILTNEEIFADWSIFKEQILLSITLFLPKAKQEFFAGQLEKIEDSLKEIKENG",
-		"GRQRKNEALAPPLLDAEPARGAGGRGGDHPSVAVGIRRVSNVSAASLVPAVPQPEADNLTLRYRSLVYQLNFDQTLRNVDKAGTWAPRELVLVVQVHNRPEYLRLLLDSLRKAQGIDNVLVIFSHDFWSTEINQLIAGVNFCPVLQVFFPFSIQLYPNEFPGSDPRDCPRDLPKNAALKLGCINAEYPDSFGHYREAKFSQTKHHWWWKLHFVWERVKILRDYAGLILFLEEDHYLAPDFYHVFKKMWKLKQQECPECDVLSLGTYSASRSFYGMADKVDVKTWKSTEHNMGLALTRNAYQKLIECTDTFCTYDDYNWDWTLQYLTVSCLPKFWKVLVPQIPRIFHAGDCGMHHKKTCRPSTQSAQIESLLNNNKQYMFPETLTISEKFTVVAISPPRKNGGWGDIRDHELCKSYRRLQ",
-		"MAQKPRTVICVGDIHGYISKLNNLWLNLQSAIDPSDFSSALVIFLGDYCDRGPETRKVIDFLISLPEKHPDQTHVFLAGNHDFAFSGFLGLLPRPSDGSDLKDTWKEYSKSEETEGWYTGEGFEDMHLQGRRWAGKIKATFNSVKGMAYKGSIYDAGSTFESYGVPHGSSDLMKAVPESHKKFLTNMVWVHEEDDVCIETEEGLKHCKLIAVHAGLEKGNNVEEQLKLLRAKDTSISKIQHLSGRKNVWDIPQELDDKHTVVVSGHHGKLHIDGMRLIIDEGGGFPDKPVAAIVLPSKKIIRDTDNLSS",
-		"MYESILSIKPYNLSKEQITWVNQTLVSLSDDEKLGQLICEIIWDKPGCDPLDVMKHFLPGAVMYRPFKAKRMREFTQRLQKASKIPLLIACNLERGGSGGNGGMEDGTYVASPMGVAATDDESSAEHLGEVCASEGSAVGVNWTYEPIIDIDMNPENPITNVRTYGSDPERIIRMAKAYCRGCRKWGVLTTIKHFPGDGVDYRDQHLMSSVNNLSADEWMDTYGRIYQALIEDGAETLMSAHIRQPNVTRMVNPLIKDEEIMPGSLSKELMQGILRGRFHFNGLICTDATQMVGYTCSMPRHEALPTSIQNGADMLTFTLNPTEDFKALQEGLSCGLLTHERLDEAVARILGMKAKLRLPERKDVVPPLHAMERIQSKKHKKWALEIADESITLVKDKQKGLLPLSPQKTKRIILVQATNEKPEGGYLSEARLFKGLLEKEGFIVHWFEEVPRPGTGYSIEDLKRDTDLFIYYANFKVSSNQTTIRLVWSDFLGDSSPKFVCDVPTLFLSFSNPYHLVDVPMVKTYINAYTSNEATVRMMIEKLMGRSSFKGKSPVDPFAGLWDARLHHHHHH",
-		"GAMDPGGGGSSAADPAIPEEVWNIKQMIKLTQEHIEALLDKFGGEHNPPSIYLEAYEEYTSKLDALQQREQQLLESLGNGTDFSVSSS",
-		"MSEVFQECVNLFIKRDIKDCLEKMSEVGFIDITVFKSNPMILDLFVSACDIMPSFTKLGLTLQSEILNIFTLDTPQCIETRKIILGDLSKLLVINKFFRCCIKVIQFNLTDHTEQEEKTLELESIMSDFIFVYITKMRTTIDVVGLQELIEIFIFQVKVKLHHKKPSPNMYWALCKTLPKLSPTLKGLYLSKDVSIEDAILNSIDNKIQKDKLEVLFQ",
-		"DRHHHHHHKLSRAHDNQPGTIRSDHYTCVGCVLVVSVIEQLAQVHNSTVQASMERLCSYLPEEWVLKTACYMMVHVFGADIIKLFDKDVNADVVCHTLEFCKQEPGQPLCHLYPLPKESWKFTLEKARHIVKQSPIMKYTR",
-		"SGAGICSLPFLAKICQKIKLAIKNSVPIKDVDSDKYSIFPTLRGYHWRGRDCNDSDKTVYPGRRPDNWDAHRDSNCNGIWGVDPKDGIPYEKKFCEGSQPRGIILLGDAAGAHFHIPPEWLTVSQMSVNSFLNLPTAVTNELDWPQLSGTTGFLDSASKIKENSIYLRLRKRNRCNHRDYQNISKNGASSRNVKSLIESLSRNQLLDHPAIVIYAMIGNDVCNGRKTDPVSAMTTPEQLYANVLKMLEALNSHLPTGSHVILYGLAHGAFLWDTLHSRYHPLGQLNKDVTYTQLYSFLGCLQVSPCPGWMSANETLRALTSERAQQLSETLRKIAASKKFTNFNLFYLDFAFQEVVEEWQKMGGQPWELIEAVDGFHPNEVALLLFADQLWEKVQRQWPDVLGKENPFNPQIEEVFGDQGGH",
-		"MTEQRPLTIALVAGETSGDILGAGLIRALKEHVPNARFVGVAGPRMQAEGCEAWYEMEELAVMGISESSGRSRRSSHIRADLTKRFGELKPDVFVGIDAPDFNITLEGNLKKQGIKTIHYVSPSVWAWRQKRVFKIGRATDLVLAFLPFEKAFYDKYNVPCRFIGHTMADAMPLDPDKNAARDVLGIPHDAHCLALLPGSRGAEVESLSADFLKTAQLLRQTYPDLEIVVPLVNAKRREQFERIKAEVAPDLSVHLLDGMGREAMVASDAALLASGTAALECMLSKCPMVVGYRMKPFTFWLAKRLVKTDYVSLPNLLAGRELVKELLQEECEPQKLAAALLPLLANGKTSHAMHDTFRELHQQIRCNADEQAAQAVLELAQ",
-		"GAMDKIQSITGSVAYRERIALPDNAVVTVYLQDVSLADAPATVIAKQNFITNGMQVPLEFNLAYDSRKIKASHRYSVSARIEVDGKLRFITDTHYGVITDPEATKHVPMMLIGVHGE",
-		"MILKILNEIASIGSTKQKQAILEKNKDNELLKRVYRLTYSRGLQYYIKKWPKPGIATQSFGMLTLTDMLDFIEFTLATRKLTGNAAIEELTGYITDGKKDDVEVLRRVMMRDLECGASVSIANKVWPGLEHHHHHH",
-		"GSPFNVVPIHNYPELMKDTCALINAEWPRSETARMRSLEASCDSLPCSLVLTTEGMCRVIAHLKLSPINSKKKACFVESVVVDKRHRGQGFGKLIMKFAEDYCRVVLDLKTIYLSTIDQDGFYERIGYEYCAPITMYGPRHCELPSLQNAKKKYMKKVL",
-		"ERCGGWVKLNTAPVCFSAKGNRPGSFTPSHHGFLKSVKLRHLRGLVTCQSSTDAHDSYWGCKNRNGFHNYPLNVFVTDKHNKVMFPKTGATYYLDPYVIKNRFYGVQGYNAMSPELVLQHGCNSPSDYIGPDSQLRVWYGEDLYNTMESDNSGKVCADVFGYFV",
-		"TGYVSTMPKVIIFTDFDGTVTGKSGNETVFTEFYQSLLQGYKKDVEQDYKNTPMKDPIEAQALFEAKYGKYNENFDHDQQDVDFLMSPEAVAFFHEVLKNDDVTVNIVTKNRAEYIKAVFKYQGFSDEEISKLTILESGYKFNDVNSRLNHPTERANRVYILDDSPTDYAEMLRAVKGKGYNEEEIRGYRKNPGEFEWSQYLEDVREMFPPKEN",
-		"GPHMGSPEFDGTLVRIWMPDGAPAYTADTEAEDPKVYEDEGVKRQWQSFLEKGRFEGGMPEVPPRREWCVWDF",
-		"SMPIILDSDVLEVAEYVYKTRLSQPYTEVGSEWEYNYKNPTATFAKGDGHNLQRYITIDGKQLHRPIAGLAHTMRTLMYSQLMYCSSKKQPSPHVCQDGRTIADLSELDLKKINIAQLFFVAGRESEASYGDAYHRYHLYGAKQFEEYARKHLTHLFSEEEIRLYSRCIEDRVGDSFDGTPEGYIIHLSHMIDLMRCKSPVEVFLGHSKGVSGIVPTLIHLFGKQDGLDIMHYARGLFAATGEAVPYIDSSEWPHLGVDLSRVQRALSIVGDINVPGQEADSKKTAQAGFSVDGCYSALTSVPTPSWYEKELKEIDDEKIDVKEVDDREIEKEHEIVVPSQATTPLSTVKTDSFVEKLLKPFMIWKKPEVQTTQPTTEKTNKP",
-		"DKIQLFRTIGRVQYWERVPRLHAYGVFALPFPMDPDVEWGNWFAGPHPKAFLVSVHPSGPKAGHVYPTDLSDPDSVANVIGMVLDGHDYEADHNVTVTLRAAVPIEYVQQGIEAPPLQPDPAVLNAAPQLKLKVIKGHYFFDYTR",
-		"MNKKSKQQEKLYNFIIAKSFQQPVGSTFTYGELRKKYNVVCSTNDQREVGRRFAYWIKYTPGLPFKIVGTKNGSLLYQKIGINPCNNSTPSKGGDC",
-		"SRNIHLLGRKTCLGRRVVQPGMFEDHPPTKKARVSMRRMSN",
-		"MSNCQYKIYPPLGIARVGNGPAIKPLSLSTPEVPWAHLYDTNVQYLVTQQELEQLLEEAFGGNVINEISQIKTKLDERKAEKFKQEEIETITGLLGLSHLVPQQQLSRSLDNLELKSTKDSDDIVQQIKGALLKVLSDHYLHAVKKQAQNFYIYKCDEQGNPVEKLKLTDGDKVTWRVEVANKKSFWYDYNNALDLSLHTQGSGNLSKNVSKHRLAPAMTAKRRNPNVITNSLRKQLVISSQGSVSSDNNTQVPLRGKFPANEPDTNNRLSDLLNLQERHNVLQGSIECDNEGVLRFYAGNGISQALSPSSLNTDFADNSNWFDDICDGRVTAVVELKNGDTFEIQDEQSSAWVATTPPDYAPQIEPIVTMYDMVSGAALKEQDLDNLTTQFSDVFPILYRLYRMQWVNQADFTDNAVNTQIRELNSELGFAQLLDNSASAKSLREGIFNQFRNPLFDQDIDVDDPGQSSNEWVSNSRIIPSKDETNIAAKPATSSLKLPFYPNDGIDYPGSPVQWFAIPPFMYQHLQNWAAGDFSVTQVEKESANTIEELGLFYSEQFKNSPNSALLCARGALDALYGGGFHPGVELTWPMRHNLIYSQNDYVSSVTPEINLLGLREFRLKQDLQGLNSPNMYQDFGHVIAVDNVTASIDPNSDAAWLWRSTPGDLTKWMGIPWQSDAASCQAVYTPEDFPIPSWWAANLPVHVLPLARYNKFKDSQSADLPEINGMTHSIAQGMSEETFEHLRLEQFSQRLDWLHTADLGFVGYHAEGGYTNGLIQMVSQWKNMAMVMARPVENPGSSGIPNVVYVAYSQADKD",
-		"SEFFWDVQKIQEISNVEEHSVVKCVTVNTSRLISQLNEELQDEESGVNFIVTQLQLLINNVYEKIQKSPGVPAHRSLMINLNFTRLKFSIAYWDILLERSLDLINGPSKTGARYFITEVTPVDRSRYVENNQYFLAFKANQRLTRNSVDMDEFIDFEILIKQIIFDLFKKNGIPDQDFEAILSRFHNLESLVVAFNE",
-		"MTDIVYDVEGFRAFLPKETLRWIRHRELERKVGVVEKFSDRVGPIPVEIRRRRSQYGEFYHAGKGTTRIQARVSAAMECVERAAAEPREEIIERGPEGDKWTPAWYRTEPREWVEGVDLTTREPVYVPANEVFHPWLGDALPSHTNGLSAGRLREEAVIQGLLEVVERDSWSIVEYFRIHPPELEVHGELEELRRSLEREVGRVELRLLPSRVEGVYVVGAVTEAERVEEMVMGFGASPDPEMAVLRALLEVAQGLSMARRGIESPVRKGLGEFSAPGKLTPERLKRLNRHWFEPEGTVEIDDLDRVITTGSLEKLTEELVERVAEAGLGKVIEVDLTLENLDVPVVRVRVTGASEYVIDEARVGNMPEKPPGVPMG",
-		"GHMGTNRPLVFVDLDDTLFQTSRKMVEGTPRTTATLDVHGQPNGYMNPIQHSFISWLLASADVVPVTARDVEAYSRVKLPFTEGAICSHGGVMLHSDGSLDQDWHGQMAKSLWAFQDRLPALSEATLRIGKDMGYSLRGWVVEEEGLRHYVVTKQNESDDAVLSKVLAEVQARGMLEGMHIHANGNNLAFLPKGLAKRLAVQEWLRRDAKINGDRPVLGFGDSITDLGFMGLCHMWATPARSQLAKAVEEMII",
-		"SGSVTAGMALAATDIPGLDASKLVSGVLAEQRLPVFARGLATAVSNSSDPNTATVPLMLTNHANGPVAGRYFYIQSMFYPDQNGNASQIATSYNATSEMYVRVSYAANPSIREWLPWQRCDIGGSFTKEADGELPGGVNLDSMVTSGWWSQSFTAQAASGANYPIVRAGLLHVYAASSNFIYQTYQAYDGESFYFRCRHSNTWFPWRRMWHGGDFNPSDYLLKSGFYWNALPGKPATFPPSAHNHDVGQLTSGILPLARGGVGSNTAAGARSTIGAGVPATASLGASGWWRDNDTGLIRQWGQVTCPADADASITFPIPFPTLCLGGYANQTSAFHPGTDASTGFRGATTTTAVIRNGYFAQAVLSWEAFGR",
-		"GSPSSQGQHKHKYHFQKTFTVSQAGNCRIMAYCDALSCLVISQPSPQASFLPGFGVKMLSTANMKSSQYIPMHGKQIRGLAFSSYLRGLLLSASLDNTIKLTSLETNTVVQTYNAGRPVWSCCWCLDEANYIYAGLANGSILVYDVRNTSSHVQELVAQKARCPLVSLSYMPRAASAAFPYGGVLAGTLEDASFWEQKMDFSHWPHVLPLEPGGCIDFQTENSSRHCLVTYRPDKNHTTIRSVLMEMSYRLDDTGNPICSCQPVHTFFGGPTCKLLTKNAIFQSPENDGNILVCTGDEAANSALLWDAASGSLLQDLQTDQPVLDICPFEVNRNSYLATLTEKMVHIYKWE",
-		"SNAMSNNNDYDISDSKDCEKFANQFIQEFPIRSAEIGQGTVIKRALPSRQKRMIGAWCFLDHAGPVTFPAGNGLDVGPHPHIGLQTFTWMIEGTMMHTDSLGSKQLIRPKQVNLMTAGHGISHTEVAPDTETQMHAAQLWIALPDHKRNMDPKFEHYPDLPVVEKDGLEFTVLVGEYLETTSPVVVHTPLVGVDLIATQDTKTRIPLNPEFEYGFMALDGVAHVNGHELTADNMVVLDTGLNEIEIEVKKGNRVLLIGGEPFETPILLWWNFVARTMDDLKEAREQWVNHDVRFGEIPDYVGARLEAPVLPDQMRASK",
-		"MRKLKYNTTRVILMIAFISLSACSSEDAMIEEEQVIPDPDPVAQTDEDTGPVVDCTNQGTNPTRDTDIPNPRNIGDIDDRSCYANYSESSILGKFWGIYNITDGSNHMDAPNTLQPRIERSLSRSQATGAGSYARFRGVLRILEVGDTGTFSSSGSYFMQAKGKHTGGGGSPDPAICLYRAHPVYGDDGNGNQVQVSFDIWREQINFRGGSGSAGRTEVFLKNVLKNEQIDIELEVGFRDDPNNPGQTLHYADAKIGGEEFNWNIPEPERGIESGIRYGAYRVKGGRAQFRWANTSYTKDEVN",
-		"GLVPRGSHMEGKKILVTGGTGQVARPVAEALAERNEVWCLGRFGTPGVEKELNDRGITTFHWDMDDPGAAAYEGLPDDFTHVLHSAVRRGEDGDVNAAVEVNSVACGRLMTHCRGAEAFLFVSTGALYKRQTLDHAYTEDDPVDGVADWLPAYPVGKIAAEGAVRAFAQVLNLPTTIARLNIAYGPGGYGGVPMLYFKRMLAGEPIPVPKEGQNWCSLLHTDDLVAHVPRLWEAAATPATLVNWGGDEAVGITDCVRYLEELTGVRARLVPSEVTRETYRFDPTRRREITGPCRVPWREGVRRTLQALHPEHLPS",
-		"SNAVGLTPQDLSALTGVTRNLPKQLTQATQVAWSGPPPGFAKCPGGQVVILGFAMHLNFKEPGTDNFRIISCPPGREKCDGVGTASSETDEGRIYILCGEEPINEIQQVVAESPAHAGASVLEASCPDETVVVGGFGISVRGGSDGLDSFSIESCTTGQTICTKAPTRGSEKNFLWMMCVDKQYPGLRELVNVAELGSHGNANKRAVNSDGNVDVKCPANSSIVLGYVMEAHTNMQFVRDKFLQCPENASECKMTGKGVDHGMLWLFDRHALFGWIICKTVNEPAMHVATDVGKAKGNGKKKKGRKGKNKTNAPNEVEEGQQLGADSPSQVSVPADADSGPTSKTMSSLKLAPVKLLDL",
-		"SNALSEVSEGNDIDRHLVRQMTVLSQGNDQYFRFVTRLSRAMDVKIGGGTPDFAPARQSLENMRQKLEEMKALSPGPMNPDISREVLSNWQALLEKGVVPQMQLAQQGSLTAWSEHASTVTPALSRAFGASAERFSHEAGAMLDNTRVMVDGKTYTIR",
-		"SPVMEQVLLSLVEGKDLSMALPSGQVCHDQQRLEVIFADLARRKDDAQQRSWALYEDEGVIRCYLEELLHILTDADPEVCKKMCKRNEFESVLALVAYYQMEHRASLRLLLLKCFGAMCSLDAAIISTLVSSVLPVELARDMQTDTQDHQKLCYSALILAMVFSMGEAVPYAHYEHLGTPFAQFLLNIVEDGLPLDTTEQLPDLCVNLLLALNLHLPAADQNVIMAALSKHANVKIFSEKLLLLLNRGDDPVRIFKHEPQPPHSVLKFLQDVFGSPATAAIFYHTDMMALIDITVRHIADLSPGDKLRMEYLSLMHAIVRTTPYLQHRHRLPDLQAILRRILNEEETSPQCQMDRMIVREMCKEFLVLGEAPS",
-		"GPLGSSEDYRDTVVKRKPASLMAPLKRKEEFSLFKVSDDEYKVTISPQLLLATQRFLSREVDVFSPLRMSEKVLLHLLKHPSVNQEVRFDESNRLATHHYLYQRSQPVDYFILILQGRVEVEIGKEGLKFENGAFTYYGVSALMVPSSVHQSPVSSLQPIRHDLQPDPGDGTHSSMYCPDYTVRALSDLQLIKVTRLQYLNALMATRAQNLPQSPENTDLQMMPGSQTRLLGEKTTTAAGSSHSRPGVPVEGSPGRNPGV",
-		"SNAMSQQVTQLNPTQQTTQSAFLATTVITAQCHAILNTQFTPPTVKPDWFDDLSKKLDSAKLVAKQWIDDLGPQVSASIPSSVINFDATFQASIDAIHELYKADPTASGKDNTTVQQASQIMTALSSQVSGIEATVKGMNKELSDWGVKMQAAHDDLVNGATNIQKTIIDLQTDIESMNNAIDNNRAAIEKLNKDLVYAQVAVGVGIFMLVAGVALTVATAGTAAAVSGGIAAVGAASIIAGGVTWGVLQNQIDDDYDSIAQEQKQKAEDQQQIIALQGLSNASSAVVSAIETSTSVLSDFETTWTVFGNELDDVVTKLNNGASMQSIIMEKVMSDAAKNEWDDAVELAKQLASAKIAIETKELAPAVKQAA",
-		"SNLSLITKLSQEDGAILFPEIDRYSDNKQIKALTQQITKVTVNGTVYKDLISDSVKDTNGWVSNMTGLHLGTKAFKDGENTIVISSKGFEDVTITVTKKDGQIHFVSAKQKQ",
-		"MKKTLPTKKSNLWFLMACLIITFHKVEAQVPDPNQGLRAEWMRGALGMLWLPERTFNGNIEGIRIDDFLTQIKDIRTVDYVQLPLTSPNIFSPTHVAPHPIIESLWQGDTDANGDPINLVAPRESVDDPLLSWLKALRAAGLRTEIYVNSYNLLARIPEDTQADYPDVSARWMEWCDTNTEAQAFINSQTYHEGNGRRKYMFCYAEFILKEYAQRYGDLIDAWCFDSADNVMEDECGDDPASEDVNDQRIYQAFADACHAGNPNAAIAFNNSVGDREGNPFTSATLFDDYTFGHPFGGAGNMVVPEALYTYNHDLVVFMQTNNGYAFRDDTRTWNDNVVAHFFPKQSTTSWNAGNTPCLTDEQFVEWTSTGIVNGGGITWGTPLVRTNLENAPVLTLQPYALNQFELTDTYLKEFQSPGKPNWSRQYTILPAIYPGQPYSHNLVEGVDFWDPEGVGITGLTASGTLPAWLTISQTATGTWTLSGTPPVSEASNYTFELMAQDSDGVTNREVKLEVISHPAGFTNPGDGTPVWFSNPMVLAKATALKDYGSLLKLGVDFYDFEGDVLTITKTSGPDWLVLTQNSDDTWRLSGMPTAADAGENSFTFNVSDGILSSDTEIKITVDHVAGFTNLGNGAPVWSSPILNLTDGKGSFAYNYTLQLGTDYYDFEGDALTITKTSGPDWLTIQQTDANSWKLSGTPINSDAGENSFTFNLSDDTNSTTAEILINVIATIIS",
-		"ESEKIYKVMEEIFVDRHYKENIRTGEEVKQYFSKSKAEFILRWSSANESDTENKYVFIAASFQASDGIHSIRYGINKNGELFSINTASNKVTPIDILPLGVMATLTQHITQNKELIEKAL",
-		"MIANVSAHRRANAFAQALEDRESEGAAAEQTETTAEPAEQGKLLALASGLGDLPKPQLDPEVKVVQRAQLVAAMEAMLMEGSAAAAPTVPE",
-		"DRHHHHHHKLVPGTPPLFNVSLDVAPEQRWLPMLRHYDPDFLRTAVAQVIGDRVPQWVLGMVGEIVSKVESFLPQPFTDEIRSICDSLSLSLADGILVNLAYEASAF",
-		"CTSIVAQDSQGRIYHGRNLDYPFGKILRKLTADVQFIKNGQIAFTGTTFVGYVGLWTGQSPHKFTISGDERDKGWWWENMIAALSLGHSPISWLIRKTLSESESFEAAVYTLAKTPLIADVYYIVGGTSPKEGVVITRDRGGPADIWPLDPLNGEWFRVETNYDHWKPAPKVDDRRTPAIKALNATGQAHLNLETLFQVLSLFPVYNSYTIYTTVMSAAEPDKYLTMIRNPS",
-	}
-	labels := []string{
-		"CCCCCTTCCEEEEEEECCCCCCTCCTTCCCCTTCCCCEEEEEEECCTTCCCCCCEEECCCEEEEEECCCEEEEEEEEEECCHHHHHHHHHHHCCCCCCCCCCCCCCCCCHHHHHHHHHHHHCCCEEEEEEEEEEEEEEEECCCCCCCCCCCHHHHHHHHTCCCCCCTTCTTCCCCHHHHHHCTTCHHCHHCCHHHHHHHHHHHCCEEEEEEEETTEEEEEEEECHHHHHHHHHTTCCHHHHHHEHHCCCCCCCCCCCCCHHHHHHCCCCCCEEEEEEEECCCCCCCTTCHHHHHHHHHHHHHCCCCEEEEEEECCCCCCHHHHHHHHHHHHHHHHHHTCCHHHHHHCCCCCCCCCC",
-		"CCCCCCCCCCCCCHHHHHHHHHHHHHHHHHHHHCCEEEEEEECCCCCEEEEEEEECCCCCCCCCCCCEEEECTTCCEEECCCCCCCCCEEEEECCCCCTTCEEEEEEEECCCCCTTCEEEEEEECTTCCEEEEEC",
-		"CCCCCCEEEEECCCEEEEEECCCCCEEEEEEEEEEEECCTTCEEEEEEEEEEECCCCCCCHHHHHCCCEEEEEEEECCCCCHHHHHCTCCCEEEEEEEEEEECCHCHHHTTCCCCTTCEEEEEEEEEEETCEEEETTCCCCCTCCCCCCCCCCCCCCCCCEEEECCCCTEEEEEEEEECHHHHHHHHCCTTCEEEEEEEECCCCCEEEEEECTTCCEEEECEETTCEEEECCCCTTCCCTTCCCCEEEEEEETTEEEEEEETTEEEEEECCCCCCCCCCCEEEEEECCCCCC",
-		"CCCEEEEEEECCCHHHHHHHHHHHHHHHHHHHHHTTCEEEEEEEECCHHHHHHHHHTTCCCEEEECHHHHHHHHHHHCCEEEEEEEETTEEEEEEEEEEETTCCCCCCCCCTTCEEEECCTTCCHHHHHHHHHHHHTTCCCCCCCCTTCCCCCCCCEEEEECCHHHHHHHHHTTCCCEEEECHHHHHHHHTCCHHHHHTEEEEEECCCCCCCEEEEETTCCHHHHHHHHHHHHHHHTCHHHHHHHHHHHTCCCEEECCHHHHHHHHHHHHHHHHHHHHCC",
-		"CCCCCHHHHHHHHHHHHHHHHHHHHHHTTCHHHHHHHHHHHHHHHHHHHHHCCCCCCCHHHHHHHHHHHHHHHHCCCHHHHHHHHHHHHHHHHHHTTCCHHHHHHHHHHHHHHHHHHCCCCCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCCCCCCCCCCCCCCCCCCCHHHHHHHHHHHHHCHHHHHHHHHHHHHHHHHHHHHHHCTTCCHHHHHHHHHHHHHCCCCCCCCCECCCHHHHHHHHHHHHHHHHHTCHHHHHHHHHHHHHCHHHHHHHHHHHHHHHHHCCC",
-		"CCCCCCCCCEEEEECCCCEECCCCCHHHHHHHHHHHHHTTCCCCCCEEEECTTCCEEEECHHHHHHHHHHTTCCEEEEEEECCCCCEEEEEEEEECCHHHHHHHHTCCCCCCCCCCCTCCCEEEEEETTEEEEEEECCCCHHHHHHHHHHHHHHHTTCCEEECCCCCCCCCCTTCEEEECCCCCHHHHHHHHHTTCCCCTTCEEEEECCCCCCEECCCCCCCCCCCHHHHHHHHHHHHHHHTCCCCCCCEEEEECC",
-		"CCCCCCCHHHHHHHHHHHHHHHHHTTCCEEEECHHHHHHHCCCCCCCCCCEEEEEECCCHHHHHHHHHHHHHHHCCTCCCCCCETCEEEEEEEEECTTCCEEEEEEEECCCCCCCCCCCEECCCCCCEEEEETTEEEEEECHHHHHHHHHHHHHHHTCCCCHHHHHHHHHHHHHHCCTTCCCCCCTTCHHHHHHHHHHHHHCHHHHHHHHHHHCCCCCCCC",
-		"CCCEEECTTEEEEEETTEEEEEETTTTEEEEECHHHHHHHHHHHCCCCHHHHHHHHHHHHTCCHHHHHHHHHHHHHHHHHTTCEEECCCCC",
-		"CCCCCCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCCCCCCCCHHHHHHHHHHHHCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCTTCHHHHHHHHHHHHHHHHHHHHHHHHHTCHHHHHHHHHHHHHHHHHHHHHHHHCCCCCCCCHHHHHHHHHHHHHHHHHHHHCCCCCCCCCC",
-		"CCCCCCCHHHHHHHHHHHHHTTCCHHHHHHTTCCHHHHHHHHTTCCCCCHHHHHHHHHHHTCCHHHHHHHHCCCCHHHHHHHHHHHHHHTTCHHHHHHHHHHHHHHHHHCCCCCCHHHHHHHHHHHHHHHCTTCCCCHHHHHHHHHHHHHHHHTCCCCHHHHHHHHHHHHHHTCCCCCHHHHHHHHHHHHTCCCCCHHHHHHHHHHHHHHHHHHHHTTCHHHHHHHHHHHHHCCCCTCCHHHHHHHHHHHHHHHHHHCCCCCCCHHHHHHHHHHHHHHHCCHHHHHHHHHHHHHHHHHHHHHHHCC",
-		"CCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCHHHHHHHHHHHHHHTCCCCCCCCCCCCCTTCEEEEEEECCCHHHHHHHHHHHHTCTTCCCEEEEEECCCCCHHHHHHHHTCCCCEEEEEECCCCCCCCTTCCTTCCTTCCCTTCCHHHHHHTTCCCCCCCCCCCCCCCCCHHHHHHHHHHHHHHHHHHHHHCTTCCCEEEEEECCCCHHHHHHHHHHHHHHHHHHHCTTCCEEEECCCCCCCCCCCCCCEEEEEECCCCCCCEEEEEEHHHHHHHHHTHHHHCCCCCCCHHHHHHHHHHHCCCCCEEEEEECCCCEEECCCCCCCCCCCCCHHHHHHHHHHHHHHHCCCCCCCEEEEEECCCEEECCCCCCCCCCCCHHHHHHHHHHHHCC",
-		"CCCCCCEEEEECCCTCCHHHHHHHHHHHHHHHCTCCCCCCEEEEECCCCCCCTCHHHHHHHHHHHHHHCTTCEEEEEECHHHHHHHHHHHCCCCCCCCCCHHHHHHHTTCCCCCCCCCCCCCCCCCCCTTCCCCCHHHHHHHCCTTCCCCCCCCCCHHHHHHTTCCCCCHHHHHTCCHHHHHHHHTCCEEEEETTEEEECCTCCCCCCEEEEECCCCTTCCHHHHHHHHHHHCCCCCCCCCCCCCCCCCCCCCCCCTCCEEEEECCCCCCEEETTEEEEECCCCCTTCCEEEEEETTCEEEEECCCCCC",
-		"CCCCCCCCCCCCCCHHHHHHHHHHHHTCCHHHHHHHHHHHCCCCCCCCCHHHHHHHHCCCEEEECCCCHHHHHHHHHHHHHHCCCCEEEEECCCCCCCCCCCCCCCCCCCCHHHHHHHHCCHHHHHHHHHHHHHHHHHTTCCEEECCCCCCCCCTTCTTTCCCCCCCCHHHHHHHHHHHHHHHHHTTCEEEEECCTTCCCCCCCCCCCCCCCCCCHHHHHHHTHHHHHHHHHTTCCEEEECCCCCHHHHHHHCTTCCCCCCCCCCCCHHHHHHHHHHHHCCCEEEEECCCCCHHHHHHCCHHHHHHHHHHTTCCEEEECCCHHHHHHHHHHHHHTTCCCHHHHHHHHHHHHHHHHHTTCCCCCCCCCHHHHHHHHTCHHHHHHHHHHHHHCCEEEEETTTTEECCCTTTCCEEEEEEECCCCCCCCCCCHHHHHHHHHHHTTCEEEEEECCCCTTCCCCHHHHHHHCCEEEEEEEEEECCCCCEEEEECCCCCCCCCCCCCTTCCEEEEECCCHHHHCCCTTCCEEEECCCCCHHHHHHHHHHHHTCCCCCCCCCCCCCTCCCCCCCCCCCCC",
-		"CCCCCCCCCCCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHTTCCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCCCCCCCCC",
-		"CCHHHHHHHHHHHTTCHHHHHHHHHHTTCCCHHHHHHCHHHHHHHHHHHHTCCCCCCCCCCHHHHHHHHHTCCCHHHHHHHHHHCCHHHHHHHHHHHHHHHHHHHHHHCCCCHHHHHHHHHHHHHHHHHHHHHHTTCCCHHHHHHHHHHHHHHHHHHHHHHCCCCCCHHHHHHHHHHCTTCHHHHHHCCCCTCCCHHHHHHHHHCHHHHHHHHHHHCC",
-		"CCCCCCCCCCCCCCCCCCCCCCCCCCCCHHHHHHHHHHHHHHHHTTCCHHHHHHHHHHTCCCCCHHHHHHHHHHHHHHHHHHHHHHTTCCHHHHHHHTTCCCCCTTCCCEEECCCCCCCHHHHHHHHHHHHHHCCCCCCCC",
-		"CCCCCCCCHHHHHHHHHHHHHHHTCCCCCCTTCCCCCCCCCCTCCCCTTCCCCCCCTTCCCCCCCCCCCCCCCCCCCCEEEECTTTCCCCCEECCTTCCCCEEEEEECHHHHTTCCCCCCCCCCCCCHHHHHTHHHHHTTCCCCCCCCCCCCCCCCCCCCCCCCHHHHHHHHHCCCCCCCCCCCCTCCCCCCHHHHHHHHHHCCCCCCCEEEEEEECCCHHHCCCCCCCCCCCCCHHHHHHHHHHHHHHHHHHCCTTCEEEEECCCCCHHHHHHHHTCCCTTCCCCCCCCHHHHHHHHHCCCCCCCCCCCCCCHHHHHHHHHHHHHHHHHHHHHHHHCCCCCEEEEEECCCHHHHHHHHHHTTCCCCCEECCCCCCCCCHHHHHHHHHHHHHHHHHHCCCCCCCCCTTCHHHHHHHCCCCCC",
-		"CCCCCCEEEEEEECCCHHHHHHHHHHHHHHHHCTTCEEEEEECHHHHHTTCEEEECCCHHCHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCCEEEEECCCCCCHHHHHHHHHTTCCEEEEECCCCCCCCHHHHHHHHHHCCEEEECCTCCHHHHHHHTCCEEEECCCCCCCCCCCCCHHHHHHHHTCCTTCCEEEEECCCCHHHHHHHHHHHHHHHHHHHHHCTTCEEEEECCCHHHHHHHHHHHHHHCTTCCEEEECCCHHHHHHHCCEEEECCCHHHHHHHHHTCCEEEEECCCHHHHHHHHHHHTCCEEECHHHHTTCCCCHHHCCTTCCHHHHHHHHHHHHTCHHHHHHHHHHHHHHHHHHHTTCHHHHHHHHHHHHC",
-		"CCCCCCEEEEEEEEECCCCCCCTTCEEEEEEEECCCTTCCCEEEEEEEEEETTCCCCEEEEEECCCCCCCTTCEEEEEEEEEETTEEEEEECCCCCCCCCTTCCCEEEEEEEECCCC",
-		"CHHHHHHHHHTCCCHHHHHHHHHHTTTCHHHHHHHHHHHCTTCCEEECCCCCCCCCCCCCCCCCHHHHHHHHHHHHHTTCCCHHHHHHHHHHHHHTCCHHHHHHHHHHHHHCCCTTCCHHHHHHHCTTCCCCCCCC",
-		"CCCCEEEECCCCCCCHHHHHHHHHHHCCCCHHHHHHHHHHCCCCCCEEEEEEETTCEEEEEEEEEEECTTCTTEEEEEEEEECCCCTTCCHHHHHHHHHHHHHHHHHCCCEEEEECCCHHHHHHHTTCEECCCCCCCCCCCCCCCCHHHHHHHHHHHCC",
-		"CCCCCCEECCCCCEEEEECTCCCEEEECCCCEEEEEEEEEEEEEEEEECCCCCCCCCCCCCCCCCCCCCCCEEEEEECTTCCEECCCCTCCCCCCTTCCCCCEEEEETCCTTCCCEEECCCCCCCCEEECTTCEEEEEECCCCCCCCCCCCCCCEEEEEEEEEC",
-		"CCCCCCCCEEEEEECCCCCEECCCCCCCCCCHHHHHHHHHCCTTCCCCCCCCCCCCHHHHHHHHHHHHCCCCTTCCTTCCCCCCCCCHHHHHHHHHHHHCTTCEEEEEECCHHHHHHHHHHHTTCCHHHHHTCEECCCHHHHHHHHHHHHCCCCCCCEEEEEECCHHHHHHHHHHHHHTTCCHHHHHHCCCCTTCCCHHHHHHHHHHHCCCCCC",
-		"CCCCCCCCTTCCEEEEECCTCCCCCCCCCCCCCCCCCCCHHHHHHHHHHHHHCCCTTCCCCCCCCCCCCCCCC",
-		"CCCCCCCHHHHHHHHHHHHHTTCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCEEEETTEEEECCCCHHHHHHHHHHHHHHHHHHHHHCCCCCCCCTCCCCCCCCHHHHHHHHHHHHHHHHTCCCCCCCCCHHHHHHHHHHHHHHHHHHHHCCCCCCHHHHHHHHHHHHHHCCCCCCCCHHHHHHHHHHHHHHHCCCCCHHHHHHHHHHHHHHHHHHHHHHCHHHHHHHHHHHHHHHHHHCCCCCCCCTTCCCCCCCCHHHHHHHHHHHCCCCCCCCCCCHHHHHHHCCCHHHHHHHHHTCCCHHHHHHHCCCCCCCCCCCCCCCHHHHHHHHHHCCCCCCCCCCCCCCHHHHHHHHHHCCCCCCCCCCCCCCCCCCCCCCC",
-		"CCHHHHHHHHHHHHHHHCCCCCETEEEECCCCCCTTCCCCCCCCCCCCCEEEEEECCCCCCTTCECCCCTTCHHHHHHHHHHHHHTCCCCCCCTEEEEEEEEEEHHHHHCCCCCCCCCCCHHHHHHCCEEEEEEEETEEEEECCC",
-		"CCCCHHHHHHHHHHHHHHHHTCCTTCEEEEHHHHHHHTCCCCHHHHHHHHHHHHHHHHHCTTCCEEEEECTTCCEEEEECCCCCCCCCCCCCCCCC",
-		"CCCCCECCCECCTTCEECCTTCCCCCCCCCCHHEEHHCCCC",
-		"CCCCCEEECCCCEEEECCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCHHHHHHHHHHHHCHHHHHHHHHHHHHHHHCCCCCCCHHHHHHHHHHHHHCCCCCCCCECCCCCCCCCCCCCCCCHHHHHCHCCCCCCCCCCCCCCCCCCCEEEEEEEECTTCCEEEEECCTTCCEEEEEEEEECCCCCCCCCCCCTCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCTTCCCCCCCCCEEECCCCEEEECTTCCCCCCCCCCCCCCCCCCCCCCHCCCCCCCCEEEEEEEECTTCCEEEECCCCCCCCCCCCCCCCCCCCCTTCEECCCCCCEEEEEEETTCCEEEECCCCCCEEEEEECCCCCTTCCCCCCHHHHHHHHHHHTTCCCCCCCCHHHHHHHHHHHHTCCCCCCCCCHHCCCCCCCCCCCCHHHHHHHHCCCHHHHHHHHHHHHHHCCCCCCCCCCCCCCCCCCCHCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCTCCCCCCCCCCCCCCCCHHHHHHHHHHHTTCCCCCCCCCCCCCCCCCCCCCCHHHHCCCCCHHHHHHHHHHHHHCCCCCCCEEEEEEHHCCCCCCCCCCCCCCCCCCCCCCCCCCEEECCCCTTCCCCCCCCCCCCCCCHHHHHCCCCCCCCCCCCCCCCTTCHHHHHTCCCCCCCCCCCCCCCCTCCCCCCCCCCCCCCCCCCHHHHHHHHCCCCCCCCCCCCHHHHHHHCCCCHCCHHHHHHHHHHHHHHHHCCCCCCCCCCCCCCHHHHHHHHHHHHHHTTEEEECCCCCTTCCCCCCEEEEEECCCCCC",
-		"CCHHHHHHHHECCTTCCCCEEEEEEEECHHHHHHHHHHHHHCCCCHHHHHHHHHHHHHHHHHHHHHHCTTCCCCCEEEEEEEEEEEECCHHHHHHHHHHHHHHCCCCCCCCEEEEEECCCHHHHHHHHHHHHHHHHHHHHHHHCCCCCCCCHHHCHHHHHHHHHHHHHHHTTCCHHHHHHHHHTCCCHHHHHHHHCC",
-		"CCCEEEETTCEEECCHHHHHHHHCCCCCCTTCEEEEEEECCCCCCEEEEEECCCCCCCEEECCCCCCHHHHHHHHHHHHHHHHHTCCHHHHHHHCCCCCCCCCCCCTCCCCEEEEEEETTTCCEEEEECCCCCCCCCCCCCCCCCCCCEECCCHHHHHHHHHHHHHHHHHHHHHHHTTCCCCCCCCCCCHHHHHHHHHHTTCEEEEEEECCCCCCCEEEEEEECCCCCCCEEEEEECCCCHHHHHHHHHHHHHHHHHHHHTTCCCCCCTTCCCCCCCCCCCHHHHHHHHHHHCCCCCCECCCCCCCCCCCCCHHHHHHHHHHHHHHTTCCEEEEEECCCTTCCCCEEEEECTTCCCEECCTTCCCCCCCCCCCCCCC",
-		"CCCCCCCCEEEECCCTTTCCCCCCCCTTCCCCEEEEEETTEEEEECCHHHHHHHHHHHHHCEEEECCCCCHHHHHTCCCCCCCCEEECTTCEEECTTCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHTTCEEEEEEEEETTEEEEEEEECCCCCHHHHHHHHHHHHHHHCCTTEEEEEETTEEEEEETTCCHHHHHHHHHHHHHHHHCCCCEEEEECCCCCHHHHHHCCEEEECCTCHHHHHHHHHCC",
-		"CCCCCCCCCCCCCCCCTCCCHHHHCCCCCCCCCCCCCCCCCCCCCCCCCTTCCCCCEEEECCCCCCCCCCEEEEEEEEECCTTCCCEEEEEECCCCCCEEEEEEEECCTTCCCECCCEECCCCCCCCCCCTCCCCTTCCCTCCCEEEEEECCCCCCCCCCCCCCCCCCEEEEEEECCCCEEEEEEEEETTCCEEEEEEETTEEECCEEEECCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCHHHHHCCCCCCCCCEEECTTCEEECTTTCEEEEEEEEECCTTCCEEEEEEECCCCCEEEEEEECCCCCCCCCCCEEEEECCCCCEEEEECCCCCCCEEEEEEEEC",
-		"CCCCCCCCCCCCEEEEEEEEECCCCCEEEEEEETTTTEEEEECCCTTCCCCTTCCEEEEETTTCCEEEEECCCCCCEEEEEECCCCTTEEEEEETTCCEEEEETTTTEEEEEEECCCCEEEEEECCCCTTEEEEEETTCCEEEEETTCCCCCCEEEECCCCCCCEEEEEEECCCCCCCCCCCCEEEEEECEEEEEEECTTCCCEEEECCCCCCCEEEEEECTTTTEEEEEECCCCCCCCEEEEEEEEEEECCCTCCCCEEEEEEEEEECCTCCCEECCEEEEECCCCTCCEEEEEEETTTTEEEEEETTTCCEEEEEECCCCEEEEEEEEETTEEEEEEEECCEEEEEECC",
-		"CCCCCCCCCCCCCCCCCCCCCCCCCEEEECCEEECTTTTCEEEEECCCCCCCCEEEEEEECCCCCCCCCCCCCCCCCCCCCCCCEEEEEEEEEEEEEECTTCCEEEECTTCEEEEECCCCEEEEEECCCTCCCEEEEEEEEECCCCCTTCCCCCCCCCCCCEEEETTEEEEEEEEECTTCCCCEEEECCEEEEEEEECTTCEEEEECCTTCCEEEEEEECEEEETTEEECTTCEEEECTTCCEEEEEECTCCEEEEECCCCCCCCEEEEEEEECCCHHHHHHHHHHHHTTCCCCCCCTTCCCCCCCCCCCCCCCCCCC",
-		"CCCHHHHHHHHHHHHHHHHHHHCCTTCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCTCCCCEEEEECCCCCCCCCCCCCEEEEEEEEECTTCCEEEEEEEECCCCCCCCCCCCCCEEEEECCCCCCCCTCCEEEEEEEEEEEEEECCCCCCCCCEEEEEEECCCCCCCCCCCCEEEEEEEEEEEECCTTCCEEEEEEEEEEEEEEEECCCCCCCCEEEEEEECCTTCEEEEEEEEEEECCTTCTTCEEEEEEEEETTEEEEEECCCCCCCCCCCCEEEEEEEETTEEEEEEETEEEEEEECC",
-		"CCCCCCCCCTTCEEEEETTTCHHHHHHHHHHHHTTCEEEEECTTCHHHHHHHHHTTCEEEECCTTCTHHHHHTTCCCCECEEEECCCCCCCTCCHHHHHHHHHHHHHHHHHHHTTCCEEEEEECCCCCCCCCCCCCCCTTCCCCCCCCCCCCCHHHHHHHHHHHHHHHHHHTCCEEEEEECCCCCTTCCCCHHHHHHHHHHTTCCCCCCCCCCCCCCCCCHHHHHHHHHHHHHHCCCCCEEEEECCCCCEEHHHHHHHHHHHHCCCCEEEECCCCCCCCCCCHHHHHHHHCCCCCCHHHHHHHHHHHHCCCCCCC",
-		"CCCCCCCHHHHHHHHTCCCCHHHHHHHCEEEEEECCCCEEEECCTCCEEEEEEEEEEECCCTTCCCEEEEECCTTCCCCCCCCCCCCCCCEEEEEEEECCCCCCEEEEEEEECCCCTCCCEEEEECCTTCEEEEEEEEEECCCCCCCCCEEEECCCTTCCEEEECCCCCCCCEEEEEEEEETTCTTCCEEEEEEEECCCCCCCCCECCTTCCEEEECCTTCEEEEEEEEEEECCCHHHHHHCCCCCTTCCEEEEEECCCCCCCCCCCCCEEEEEEEEEECCCCCCEEEEEECCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCEEEECCEECCC",
-		"CCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHTTCCCCCHHHHHHHHHHHHHHHHHHHCCCCCCCHHHHHHHHHHHHHHHHHTHHHHHHHHHTTCHHHHHHHHHHHCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCC",
-		"CCHHHHHHHHHHHCCCCCCCCCTCCCCHHHHHHHHHHHHHHHHCCCHCCCCCCCCCCHHHHHHHHHHHHHHHHHCCHHHHHHHHHTTCHHHHHHHHHHHHHCCCHHHHHHHHHHHHHHHTCCHHHHHHHHHTCCCHHHHHHHHHCCCCHHHHHHHHHHHHHHHHHTCCCCHHHHHHCCHHHHHHHHHHHHCCCCCCCCCCCCHHHHHHHHHHHHTCCCCCCCHHHHHHHHCTTHHHHHHHHHHHHHTCCCCCEEECCCCCCCCHHHHHHHHHHTCHHHHHHCCHHHHHHHHHHHHHHHHTCCTTCHHHHHHHHHHHHHHHHCCCCCCCCCHHHHHHHHHHHHHCCCCCCCCHHHHHHHHHHHHHHHHHCCCCC",
-		"CCCCCCCCCCCCTCCCCCCCCCCCCCCCCCCCCCCCCTCCCCCCCCHHHHHHHHHHHHHCCCCCCCCCCCHHHHHHHHHCCTEEEEEECCTTCCCCCCEEEEETTEECCEEEEEEEEEEEEEECCCCEEEECCCCEEECHHHHCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCEEEEECCCEEEEEEEHHHHHHHHHHHHHHCCCCCCCCCCCCCCTTCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCC",
-		"CCCCCCCCCCCCHHHHHHHHHHHHHHHHHHHHHHHHHCCCCCCCCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCCCCCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCEEEEEEEEEHCCCCCHHHHHHHHEEEEEEEHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCCEEEEECCCCCCCCCC",
-		"CCCCCEEEEEEETTEEEECCCCCCCCCHHHHHHHHHHCEEEETTEEEEEEECCCCTCCCEEEEECEEEEECCCCCCCEEEEEEEEETTCCCEEEEEEEETTEEEEEECCCCC",
-		"CCCCCCCHHHHHHHHHHHHHHHHHHHHHHCCCTTCHHHHHHCCECEEEEEECCCCCCCCEEECCHHHHHHHHHTCTTCCEEEEECCCCCCCCCCCCCCCHHHHHHHCCCCCTTCCCCCCCCCCCCCCCHHHHHHHHHHHTTCEEEEEEECCCCCCCCCCCCCCCCHHHHHHHHHHHCCCHHHHHHHHHCCCCCCCCCCHHHHHHHHHHHHHHHHHHCCCEEEEEEECCCCCCCCCTCCCCCCCCCCHHHHHHHHHHHHHHTCCTCEEEEECCCCCCTCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCEEEEEEECTTCCCCCCCCEEECCCCECCCCCCCCCCCCCTCCCCCCCCCCCCCCCEEEEETTCEEEECCCCEECCCCCCCEEEEEECCCCCCECCCCEEEECCCCCCCCCCCCCCCCCCCCTTCCEEEEEEECCCCCCTTCCCCEEEECCCCCCCEEEECCCCCCEEEEEECCCCCCCEEEEEEEEEECTTCCCEEEEEEEEECCCCCCCCTTCCCCEECCCCCECCCCCCEEEECEEEEEEEEEECTTCCEEEEEEECCCCEEEEEECTTCCEEEEECCCCCCCEEEEEEEEEECCCCCCCEEEEEEEECCCCCCCCCCCCCEEECCCEEEECCCCCEEEEEEEECCCCEECTTCCEEEEEEECCCTTEEEEEEETTTEEEEECCCCCCCEEEEEEEEEECCCCCCEEEEEEEECCCCCC",
-		"CHHHHHHHHHHHHHTTCCCCCCCCHHHHHHHHHCCCCCEEEEECCCCCCCCCCEEEEEEEEEEECTCEEEEEEEECTTCCEEEEETTTTEEEEEECCHHHHHHHHHHHHHHHHHHHHHCC",
-		"CCCCCCHHHHHHHHHHHHHHCCCCCCCCCCCCCCCCCHHHHHHHHHHHHHHTCCCCCCCHHHHHHHHHHHHHHHHHHHHHCCCCCCCCCCC",
-		"CCCCCCCCCCCCCCCCEEEEETTCCHHHHHHHHHHTCCHHHHHHHHHHHHHTTCCHHHHHHHHHHHHHHHHTCCCCCHHHHHHHHHHHTCCHHHHHHHHHHHHHHCC",
-		"CCEEEEECTTCCEEEEEECCCCCCHHHCTCEEEEEEEETTEEEEEEEEEHHHHHHHHHCCTTEEEEEEEECCCCCHHHHHHHHHHTTCCCHHHHHHHHHHHCCCHHHHHHHHHTCCCCCCEEEEEEECCTTEEEEEEECTTCCEEEEECCTTTCCEEEEEECCCCCCCCCCCCCTHHHHHHHHHHHCCCCCCHHHHHHHHHCCCCCCCCCEEEEEEECCCTTEEEEEECCCC",
-	}
-
-	// Train HMM using EM Algorithm
-	hmm.TrainEM(sequences, labels)
-
-	// Print Results
-	fmt.Println("Trained Initial Probabilities:", hmm.Initial)
-	fmt.Println("Trained Transition Probabilities:")
-	for i := 0; i < len(states); i++ {
-		fmt.Printf("%s: %v\n", states[i], hmm.Transition[i])
-	}
-	fmt.Println("Trained Emission Probabilities:")
-	for i := 0; i < len(states); i++ {
-		fmt.Printf("%s: %v\n", states[i], hmm.Emission[i])
+// normalizeCountRows applies normalizeCounts to every row of a count matrix.
+func normalizeCountRows(counts, previous [][]float64) [][]float64 {
+	probs := make([][]float64, len(counts))
+	for i := range counts {
+		probs[i] = normalizeCounts(counts[i], previous[i])
 	}
+	return probs
+}
+
+// defaultTrainingSequences are the example protein sequences used to train the HMM when no dataset is given
+var defaultTrainingSequences = []string{
+	"ETGTVPAINYLGAGYDHVRGNPVGDPSSMGDPGIRPPVLRFTYAQNEDGVSNDLTVLQPLGGYVRQYVACRQSETISELSNLSDYQNELSVDASLQGGDPIGLNSFSASTGYRDFAKEVSKKDTRTYMLKNYCMRYEAGVAQSNHFKWNVTLAFAAGVSQLPDVFDAHNPECACSAEQWRQDQNAEACTKTNVPIWISFIEQFGTHFLVRLFAGGKMTYQVTAKRSEVEKMRNMGIDVKTQLKMQLGGVSGGAGQGTSSKKQQSSSEYQMNVQKETLVIGGRPPGQVSDPAALAAWADTVEELPMPVKFEVQPLYHLLPVEKQEAFKQAVTFYSKAVGLTPQDLSALGTKHHHHHH",
+	"MGSSHHHHHHSQDPNSISTTMSSYSIQQSQKMLTQLQIDYATNTSSNTVVAYLHNVGETTISYLQNSVVYFGPNGQLQPVGYNSGSSPYWTVTSNSLQPGSVVKIIIYLSSPLSSNQYYTIQIVTPNGYTVSYMF",
+	"KKPGVGTYATVDKLKAFDVTDGKKDAFTIKDTVRLYNVEEGKTYAIAGQLYEQSVAGDEGSALAKAATTVKVTASMAKPATEVEKTKYGEDVKVYETEMDLTVKREDLTKNQVVKDDIALVVYEQLWAEGTYEKVNDTEVTPKGKSEPVAKHNDPQSSSQSITAEPQFGSLKLTKTVTGWEDAFAKVARPEASYKFTVKCVQKGSVDEFTLKEGEEKTVEGIPLGDTCTISEDVQGAVNQAGLKDTVKFTAVNGVTVDSQVNGEAVVKIGGTANGSDTVANVEVTAENSFSY",
+	"MSSVLVLGRISDDPASHYEQLKPLLDYVVPRMREVGIRRGEILMAPDARQMSSYLRRGRVDWVSETTGAAMLLEQRGSAHPLLMTERGGLRDFHTLFFVRRDSPIHSLSQLRGHTLALQNASSTSGYLLPMLELLRNGIACDVLLSADDTPARGSAGYLMVGSKLNVAAFVHKHLIDVGALSNVDWDDERHMPPVFKRDFRIVHRTAPVPRAVEMVRTGMDPAVEQRLRVVLLQAASDPKAGPALKRFFDTTGFRPLDPTSRRRLQELSAGVQRVRDHVE",
+	"SLLLDDVDNEMAAIAMQGFRSMIEQFNVNNPATAKELQAMEAQLTAMSDQLVGADGELPAEIQAIKDALAQALKQPSADGLATAMGQVAFAAAKVGGGSAGTAGTVQMNVKQLYKTAFSSTSSSSYAAALSDGYSAYKTLNSLYSESRSGVQSAISQTANPALSRSVSRSGIESQGRSADASQRAAETIVRDSQTLGDVYSRLQVLDSLMSTIVSNPQANQEEIMQKLTASISKAPQFGYPAVQNSVDSLQKFAAQLEREFVDGERSLAESQENAFRKQPAFIQQVLVNIASLFSGYLS",
+	"GSMKQIYDTLQLIPVDKIDLHEAFEPSRLEKTKESIAKEQHLRHPVLVVKTLFGRYMVIDGVHRFMSLKALGCEVIPVQVIQRTQYSIGSWHHKIPNGAWCEGLTDEELLPWTTEVRDETPFITMCDQQTEHYLYVADLTVDKLDIWKKVVNSYSASCNVERVPHSACLCLDSNDILMKYQPLQIGEIEAVVQRGQTVPAGVTRFNIAGRCLNLQVPLHLLKNSNLGNQEQWHTFLQKKIESMRCYTEKIYLIEAE",
+	"AHMAPLSSDELKTVVSVLAQKLDSLNIDYAIMGGAATCLLSGDPNRRTEDVDLVIHVDHRKITADNLTTQLLKSFPSDFEGVSQFGHTIPAYKLRRPGGTVQLVELEVFDYQSWPQRPQYDLQTATRTTLNINGQKVKLFSPEWILREKILSQYQRQGSRKEGTDIRDIISMIPLAVPGKPELNFNQSQELQTALANLVQKRPDLSSALKAKIKCSAVFHN",
+	"SNAMKISDAVVSAHIDDEVVLLHLQTGTYFGLDAVGSRIWSLLEEGKRPEEIVDAICAEYSVDRPTVERDLRDFLRALANKELLEGYADEA",
+	"MDKNWFSTPQEIREGIKYLSAHFYPASIMDRWKILKKLSFEKAKIIANYSLQQVIEEIEHFDFFNEYFKEDPLTTVRLPPSYIKLFDGLVEDFQSSRWRENIATRFHMITEGVLATVGLKILNETSRKYNLLKFNEGIKRIIEDEARHVSFGLSLIEDKEYAVKRVEELFPLAVQIVKEGKDKIEPLGYSIQELVNLMEELKKARINKILGSGSHHHHHH",
+	"QKEYMEYRPLGEEIERIRKGKNIPLRVFDENGVSSRSYQRFVQGNSELRISDLAIIVEILSISPMEMTEKLTPMSKTVLAKEQFNQAIFSKNFQESSRIVADYRAYYEKSSFALGKQEVMYSMLALEYLFNPQTVVTKEEIIALENQILERLINADVYTIFNLKFLALQKNVGLQPFPTSLLFRVLQSVNEREIIDIRSLEIIEQVIIDFLFAAIVSQNVPHILHVLSMFKEYEVGENNWRMILWKKIAEKIEMILTNEEIFADWSIFKEQILLSITLFLPKAKQEFFAGQLEKIEDSLKEIKENG",
+	"GRQRKNEALAPPLLDAEPARGAGGRGGDHPSVAVGIRRVSNVSAASLVPAVPQPEADNLTLRYRSLVYQLNFDQTLRNVDKAGTWAPRELVLVVQVHNRPEYLRLLLDSLRKAQGIDNVLVIFSHDFWSTEINQLIAGVNFCPVLQVFFPFSIQLYPNEFPGSDPRDCPRDLPKNAALKLGCINAEYPDSFGHYREAKFSQTKHHWWWKLHFVWERVKILRDYAGLILFLEEDHYLAPDFYHVFKKMWKLKQQECPECDVLSLGTYSASRSFYGMADKVDVKTWKSTEHNMGLALTRNAYQKLIECTDTFCTYDDYNWDWTLQYLTVSCLPKFWKVLVPQIPRIFHAGDCGMHHKKTCRPSTQSAQIESLLNNNKQYMFPETLTISEKFTVVAISPPRKNGGWGDIRDHELCKSYRRLQ",
+	"MAQKPRTVICVGDIHGYISKLNNLWLNLQSAIDPSDFSSALVIFLGDYCDRGPETRKVIDFLISLPEKHPDQTHVFLAGNHDFAFSGFLGLLPRPSDGSDLKDTWKEYSKSEETEGWYTGEGFEDMHLQGRRWAGKIKATFNSVKGMAYKGSIYDAGSTFESYGVPHGSSDLMKAVPESHKKFLTNMVWVHEEDDVCIETEEGLKHCKLIAVHAGLEKGNNVEEQLKLLRAKDTSISKIQHLSGRKNVWDIPQELDDKHTVVVSGHHGKLHIDGMRLIIDEGGGFPDKPVAAIVLPSKKIIRDTDNLSS",
+	"MYESILSIKPYNLSKEQITWVNQTLVSLSDDEKLGQLICEIIWDKPGCDPLDVMKHFLPGAVMYRPFKAKRMREFTQRLQKASKIPLLIACNLERGGSGGNGGMEDGTYVASPMGVAATDDESSAEHLGEVCASEGSAVGVNWTYEPIIDIDMNPENPITNVRTYGSDPERIIRMAKAYCRGCRKWGVLTTIKHFPGDGVDYRDQHLMSSVNNLSADEWMDTYGRIYQALIEDGAETLMSAHIRQPNVTRMVNPLIKDEEIMPGSLSKELMQGILRGRFHFNGLICTDATQMVGYTCSMPRHEALPTSIQNGADMLTFTLNPTEDFKALQEGLSCGLLTHERLDEAVARILGMKAKLRLPERKDVVPPLHAMERIQSKKHKKWALEIADESITLVKDKQKGLLPLSPQKTKRIILVQATNEKPEGGYLSEARLFKGLLEKEGFIVHWFEEVPRPGTGYSIEDLKRDTDLFIYYANFKVSSNQTTIRLVWSDFLGDSSPKFVCDVPTLFLSFSNPYHLVDVPMVKTYINAYTSNEATVRMMIEKLMGRSSFKGKSPVDPFAGLWDARLHHHHHH",
+	"GAMDPGGGGSSAADPAIPEEVWNIKQMIKLTQEHIEALLDKFGGEHNPPSIYLEAYEEYTSKLDALQQREQQLLESLGNGTDFSVSSS",
+	"MSEVFQECVNLFIKRDIKDCLEKMSEVGFIDITVFKSNPMILDLFVSACDIMPSFTKLGLTLQSEILNIFTLDTPQCIETRKIILGDLSKLLVINKFFRCCIKVIQFNLTDHTEQEEKTLELESIMSDFIFVYITKMRTTIDVVGLQELIEIFIFQVKVKLHHKKPSPNMYWALCKTLPKLSPTLKGLYLSKDVSIEDAILNSIDNKIQKDKLEVLFQ",
+	"DRHHHHHHKLSRAHDNQPGTIRSDHYTCVGCVLVVSVIEQLAQVHNSTVQASMERLCSYLPEEWVLKTACYMMVHVFGADIIKLFDKDVNADVVCHTLEFCKQEPGQPLCHLYPLPKESWKFTLEKARHIVKQSPIMKYTR",
+	"SGAGICSLPFLAKICQKIKLAIKNSVPIKDVDSDKYSIFPTLRGYHWRGRDCNDSDKTVYPGRRPDNWDAHRDSNCNGIWGVDPKDGIPYEKKFCEGSQPRGIILLGDAAGAHFHIPPEWLTVSQMSVNSFLNLPTAVTNELDWPQLSGTTGFLDSASKIKENSIYLRLRKRNRCNHRDYQNISKNGASSRNVKSLIESLSRNQLLDHPAIVIYAMIGNDVCNGRKTDPVSAMTTPEQLYANVLKMLEALNSHLPTGSHVILYGLAHGAFLWDTLHSRYHPLGQLNKDVTYTQLYSFLGCLQVSPCPGWMSANETLRALTSERAQQLSETLRKIAASKKFTNFNLFYLDFAFQEVVEEWQKMGGQPWELIEAVDGFHPNEVALLLFADQLWEKVQRQWPDVLGKENPFNPQIEEVFGDQGGH",
+	"MTEQRPLTIALVAGETSGDILGAGLIRALKEHVPNARFVGVAGPRMQAEGCEAWYEMEELAVMGISESSGRSRRSSHIRADLTKRFGELKPDVFVGIDAPDFNITLEGNLKKQGIKTIHYVSPSVWAWRQKRVFKIGRATDLVLAFLPFEKAFYDKYNVPCRFIGHTMADAMPLDPDKNAARDVLGIPHDAHCLALLPGSRGAEVESLSADFLKTAQLLRQTYPDLEIVVPLVNAKRREQFERIKAEVAPDLSVHLLDGMGREAMVASDAALLASGTAALECMLSKCPMVVGYRMKPFTFWLAKRLVKTDYVSLPNLLAGRELVKELLQEECEPQKLAAALLPLLANGKTSHAMHDTFRELHQQIRCNADEQAAQAVLELAQ",
+	"GAMDKIQSITGSVAYRERIALPDNAVVTVYLQDVSLADAPATVIAKQNFITNGMQVPLEFNLAYDSRKIKASHRYSVSARIEVDGKLRFITDTHYGVITDPEATKHVPMMLIGVHGE",
+	"MILKILNEIASIGSTKQKQAILEKNKDNELLKRVYRLTYSRGLQYYIKKWPKPGIATQSFGMLTLTDMLDFIEFTLATRKLTGNAAIEELTGYITDGKKDDVEVLRRVMMRDLECGASVSIANKVWPGLEHHHHHH",
+	"GSPFNVVPIHNYPELMKDTCALINAEWPRSETARMRSLEASCDSLPCSLVLTTEGMCRVIAHLKLSPINSKKKACFVESVVVDKRHRGQGFGKLIMKFAEDYCRVVLDLKTIYLSTIDQDGFYERIGYEYCAPITMYGPRHCELPSLQNAKKKYMKKVL",
+	"ERCGGWVKLNTAPVCFSAKGNRPGSFTPSHHGFLKSVKLRHLRGLVTCQSSTDAHDSYWGCKNRNGFHNYPLNVFVTDKHNKVMFPKTGATYYLDPYVIKNRFYGVQGYNAMSPELVLQHGCNSPSDYIGPDSQLRVWYGEDLYNTMESDNSGKVCADVFGYFV",
+	"TGYVSTMPKVIIFTDFDGTVTGKSGNETVFTEFYQSLLQGYKKDVEQDYKNTPMKDPIEAQALFEAKYGKYNENFDHDQQDVDFLMSPEAVAFFHEVLKNDDVTVNIVTKNRAEYIKAVFKYQGFSDEEISKLTILESGYKFNDVNSRLNHPTERANRVYILDDSPTDYAEMLRAVKGKGYNEEEIRGYRKNPGEFEWSQYLEDVREMFPPKEN",
+	"GPHMGSPEFDGTLVRIWMPDGAPAYTADTEAEDPKVYEDEGVKRQWQSFLEKGRFEGGMPEVPPRREWCVWDF",
+	"SMPIILDSDVLEVAEYVYKTRLSQPYTEVGSEWEYNYKNPTATFAKGDGHNLQRYITIDGKQLHRPIAGLAHTMRTLMYSQLMYCSSKKQPSPHVCQDGRTIADLSELDLKKINIAQLFFVAGRESEASYGDAYHRYHLYGAKQFEEYARKHLTHLFSEEEIRLYSRCIEDRVGDSFDGTPEGYIIHLSHMIDLMRCKSPVEVFLGHSKGVSGIVPTLIHLFGKQDGLDIMHYARGLFAATGEAVPYIDSSEWPHLGVDLSRVQRALSIVGDINVPGQEADSKKTAQAGFSVDGCYSALTSVPTPSWYEKELKEIDDEKIDVKEVDDREIEKEHEIVVPSQATTPLSTVKTDSFVEKLLKPFMIWKKPEVQTTQPTTEKTNKP",
+	"DKIQLFRTIGRVQYWERVPRLHAYGVFALPFPMDPDVEWGNWFAGPHPKAFLVSVHPSGPKAGHVYPTDLSDPDSVANVIGMVLDGHDYEADHNVTVTLRAAVPIEYVQQGIEAPPLQPDPAVLNAAPQLKLKVIKGHYFFDYTR",
+	"MNKKSKQQEKLYNFIIAKSFQQPVGSTFTYGELRKKYNVVCSTNDQREVGRRFAYWIKYTPGLPFKIVGTKNGSLLYQKIGINPCNNSTPSKGGDC",
+	"SRNIHLLGRKTCLGRRVVQPGMFEDHPPTKKARVSMRRMSN",
+	"MSNCQYKIYPPLGIARVGNGPAIKPLSLSTPEVPWAHLYDTNVQYLVTQQELEQLLEEAFGGNVINEISQIKTKLDERKAEKFKQEEIETITGLLGLSHLVPQQQLSRSLDNLELKSTKDSDDIVQQIKGALLKVLSDHYLHAVKKQAQNFYIYKCDEQGNPVEKLKLTDGDKVTWRVEVANKKSFWYDYNNALDLSLHTQGSGNLSKNVSKHRLAPAMTAKRRNPNVITNSLRKQLVISSQGSVSSDNNTQVPLRGKFPANEPDTNNRLSDLLNLQERHNVLQGSIECDNEGVLRFYAGNGISQALSPSSLNTDFADNSNWFDDICDGRVTAVVELKNGDTFEIQDEQSSAWVATTPPDYAPQIEPIVTMYDMVSGAALKEQDLDNLTTQFSDVFPILYRLYRMQWVNQADFTDNAVNTQIRELNSELGFAQLLDNSASAKSLREGIFNQFRNPLFDQDIDVDDPGQSSNEWVSNSRIIPSKDETNIAAKPATSSLKLPFYPNDGIDYPGSPVQWFAIPPFMYQHLQNWAAGDFSVTQVEKESANTIEELGLFYSEQFKNSPNSALLCARGALDALYGGGFHPGVELTWPMRHNLIYSQNDYVSSVTPEINLLGLREFRLKQDLQGLNSPNMYQDFGHVIAVDNVTASIDPNSDAAWLWRSTPGDLTKWMGIPWQSDAASCQAVYTPEDFPIPSWWAANLPVHVLPLARYNKFKDSQSADLPEINGMTHSIAQGMSEETFEHLRLEQFSQRLDWLHTADLGFVGYHAEGGYTNGLIQMVSQWKNMAMVMARPVENPGSSGIPNVVYVAYSQADKD",
+	"SEFFWDVQKIQEISNVEEHSVVKCVTVNTSRLISQLNEELQDEESGVNFIVTQLQLLINNVYEKIQKSPGVPAHRSLMINLNFTRLKFSIAYWDILLERSLDLINGPSKTGARYFITEVTPVDRSRYVENNQYFLAFKANQRLTRNSVDMDEFIDFEILIKQIIFDLFKKNGIPDQDFEAILSRFHNLESLVVAFNE",
+	"MTDIVYDVEGFRAFLPKETLRWIRHRELERKVGVVEKFSDRVGPIPVEIRRRRSQYGEFYHAGKGTTRIQARVSAAMECVERAAAEPREEIIERGPEGDKWTPAWYRTEPREWVEGVDLTTREPVYVPANEVFHPWLGDALPSHTNGLSAGRLREEAVIQGLLEVVERDSWSIVEYFRIHPPELEVHGELEELRRSLEREVGRVELRLLPSRVEGVYVVGAVTEAERVEEMVMGFGASPDPEMAVLRALLEVAQGLSMARRGIESPVRKGLGEFSAPGKLTPERLKRLNRHWFEPEGTVEIDDLDRVITTGSLEKLTEELVERVAEAGLGKVIEVDLTLENLDVPVVRVRVTGASEYVIDEARVGNMPEKPPGVPMG",
+	"GHMGTNRPLVFVDLDDTLFQTSRKMVEGTPRTTATLDVHGQPNGYMNPIQHSFISWLLASADVVPVTARDVEAYSRVKLPFTEGAICSHGGVMLHSDGSLDQDWHGQMAKSLWAFQDRLPALSEATLRIGKDMGYSLRGWVVEEEGLRHYVVTKQNESDDAVLSKVLAEVQARGMLEGMHIHANGNNLAFLPKGLAKRLAVQEWLRRDAKINGDRPVLGFGDSITDLGFMGLCHMWATPARSQLAKAVEEMII",
+	"SGSVTAGMALAATDIPGLDASKLVSGVLAEQRLPVFARGLATAVSNSSDPNTATVPLMLTNHANGPVAGRYFYIQSMFYPDQNGNASQIATSYNATSEMYVRVSYAANPSIREWLPWQRCDIGGSFTKEADGELPGGVNLDSMVTSGWWSQSFTAQAASGANYPIVRAGLLHVYAASSNFIYQTYQAYDGESFYFRCRHSNTWFPWRRMWHGGDFNPSDYLLKSGFYWNALPGKPATFPPSAHNHDVGQLTSGILPLARGGVGSNTAAGARSTIGAGVPATASLGASGWWRDNDTGLIRQWGQVTCPADADASITFPIPFPTLCLGGYANQTSAFHPGTDASTGFRGATTTTAVIRNGYFAQAVLSWEAFGR",
+	"GSPSSQGQHKHKYHFQKTFTVSQAGNCRIMAYCDALSCLVISQPSPQASFLPGFGVKMLSTANMKSSQYIPMHGKQIRGLAFSSYLRGLLLSASLDNTIKLTSLETNTVVQTYNAGRPVWSCCWCLDEANYIYAGLANGSILVYDVRNTSSHVQELVAQKARCPLVSLSYMPRAASAAFPYGGVLAGTLEDASFWEQKMDFSHWPHVLPLEPGGCIDFQTENSSRHCLVTYRPDKNHTTIRSVLMEMSYRLDDTGNPICSCQPVHTFFGGPTCKLLTKNAIFQSPENDGNILVCTGDEAANSALLWDAASGSLLQDLQTDQPVLDICPFEVNRNSYLATLTEKMVHIYKWE",
+	"SNAMSNNNDYDISDSKDCEKFANQFIQEFPIRSAEIGQGTVIKRALPSRQKRMIGAWCFLDHAGPVTFPAGNGLDVGPHPHIGLQTFTWMIEGTMMHTDSLGSKQLIRPKQVNLMTAGHGISHTEVAPDTETQMHAAQLWIALPDHKRNMDPKFEHYPDLPVVEKDGLEFTVLVGEYLETTSPVVVHTPLVGVDLIATQDTKTRIPLNPEFEYGFMALDGVAHVNGHELTADNMVVLDTGLNEIEIEVKKGNRVLLIGGEPFETPILLWWNFVARTMDDLKEAREQWVNHDVRFGEIPDYVGARLEAPVLPDQMRASK",
+	"MRKLKYNTTRVILMIAFISLSACSSEDAMIEEEQVIPDPDPVAQTDEDTGPVVDCTNQGTNPTRDTDIPNPRNIGDIDDRSCYANYSESSILGKFWGIYNITDGSNHMDAPNTLQPRIERSLSRSQATGAGSYARFRGVLRILEVGDTGTFSSSGSYFMQAKGKHTGGGGSPDPAICLYRAHPVYGDDGNGNQVQVSFDIWREQINFRGGSGSAGRTEVFLKNVLKNEQIDIELEVGFRDDPNNPGQTLHYADAKIGGEEFNWNIPEPERGIESGIRYGAYRVKGGRAQFRWANTSYTKDEVN",
+	"GLVPRGSHMEGKKILVTGGTGQVARPVAEALAERNEVWCLGRFGTPGVEKELNDRGITTFHWDMDDPGAAAYEGLPDDFTHVLHSAVRRGEDGDVNAAVEVNSVACGRLMTHCRGAEAFLFVSTGALYKRQTLDHAYTEDDPVDGVADWLPAYPVGKIAAEGAVRAFAQVLNLPTTIARLNIAYGPGGYGGVPMLYFKRMLAGEPIPVPKEGQNWCSLLHTDDLVAHVPRLWEAAATPATLVNWGGDEAVGITDCVRYLEELTGVRARLVPSEVTRETYRFDPTRRREITGPCRVPWREGVRRTLQALHPEHLPS",
+	"SNAVGLTPQDLSALTGVTRNLPKQLTQATQVAWSGPPPGFAKCPGGQVVILGFAMHLNFKEPGTDNFRIISCPPGREKCDGVGTASSETDEGRIYILCGEEPINEIQQVVAESPAHAGASVLEASCPDETVVVGGFGISVRGGSDGLDSFSIESCTTGQTICTKAPTRGSEKNFLWMMCVDKQYPGLRELVNVAELGSHGNANKRAVNSDGNVDVKCPANSSIVLGYVMEAHTNMQFVRDKFLQCPENASECKMTGKGVDHGMLWLFDRHALFGWIICKTVNEPAMHVATDVGKAKGNGKKKKGRKGKNKTNAPNEVEEGQQLGADSPSQVSVPADADSGPTSKTMSSLKLAPVKLLDL",
+	"SNALSEVSEGNDIDRHLVRQMTVLSQGNDQYFRFVTRLSRAMDVKIGGGTPDFAPARQSLENMRQKLEEMKALSPGPMNPDISREVLSNWQALLEKGVVPQMQLAQQGSLTAWSEHASTVTPALSRAFGASAERFSHEAGAMLDNTRVMVDGKTYTIR",
+	"SPVMEQVLLSLVEGKDLSMALPSGQVCHDQQRLEVIFADLARRKDDAQQRSWALYEDEGVIRCYLEELLHILTDADPEVCKKMCKRNEFESVLALVAYYQMEHRASLRLLLLKCFGAMCSLDAAIISTLVSSVLPVELARDMQTDTQDHQKLCYSALILAMVFSMGEAVPYAHYEHLGTPFAQFLLNIVEDGLPLDTTEQLPDLCVNLLLALNLHLPAADQNVIMAALSKHANVKIFSEKLLLLLNRGDDPVRIFKHEPQPPHSVLKFLQDVFGSPATAAIFYHTDMMALIDITVRHIADLSPGDKLRMEYLSLMHAIVRTTPYLQHRHRLPDLQAILRRILNEEETSPQCQMDRMIVREMCKEFLVLGEAPS",
+	"GPLGSSEDYRDTVVKRKPASLMAPLKRKEEFSLFKVSDDEYKVTISPQLLLATQRFLSREVDVFSPLRMSEKVLLHLLKHPSVNQEVRFDESNRLATHHYLYQRSQPVDYFILILQGRVEVEIGKEGLKFENGAFTYYGVSALMVPSSVHQSPVSSLQPIRHDLQPDPGDGTHSSMYCPDYTVRALSDLQLIKVTRLQYLNALMATRAQNLPQSPENTDLQMMPGSQTRLLGEKTTTAAGSSHSRPGVPVEGSPGRNPGV",
+	"SNAMSQQVTQLNPTQQTTQSAFLATTVITAQCHAILNTQFTPPTVKPDWFDDLSKKLDSAKLVAKQWIDDLGPQVSASIPSSVINFDATFQASIDAIHELYKADPTASGKDNTTVQQASQIMTALSSQVSGIEATVKGMNKELSDWGVKMQAAHDDLVNGATNIQKTIIDLQTDIESMNNAIDNNRAAIEKLNKDLVYAQVAVGVGIFMLVAGVALTVATAGTAAAVSGGIAAVGAASIIAGGVTWGVLQNQIDDDYDSIAQEQKQKAEDQQQIIALQGLSNASSAVVSAIETSTSVLSDFETTWTVFGNELDDVVTKLNNGASMQSIIMEKVMSDAAKNEWDDAVELAKQLASAKIAIETKELAPAVKQAA",
+	"SNLSLITKLSQEDGAILFPEIDRYSDNKQIKALTQQITKVTVNGTVYKDLISDSVKDTNGWVSNMTGLHLGTKAFKDGENTIVISSKGFEDVTITVTKKDGQIHFVSAKQKQ",
+	"MKKTLPTKKSNLWFLMACLIITFHKVEAQVPDPNQGLRAEWMRGALGMLWLPERTFNGNIEGIRIDDFLTQIKDIRTVDYVQLPLTSPNIFSPTHVAPHPIIESLWQGDTDANGDPINLVAPRESVDDPLLSWLKALRAAGLRTEIYVNSYNLLARIPEDTQADYPDVSARWMEWCDTNTEAQAFINSQTYHEGNGRRKYMFCYAEFILKEYAQRYGDLIDAWCFDSADNVMEDECGDDPASEDVNDQRIYQAFADACHAGNPNAAIAFNNSVGDREGNPFTSATLFDDYTFGHPFGGAGNMVVPEALYTYNHDLVVFMQTNNGYAFRDDTRTWNDNVVAHFFPKQSTTSWNAGNTPCLTDEQFVEWTSTGIVNGGGITWGTPLVRTNLENAPVLTLQPYALNQFELTDTYLKEFQSPGKPNWSRQYTILPAIYPGQPYSHNLVEGVDFWDPEGVGITGLTASGTLPAWLTISQTATGTWTLSGTPPVSEASNYTFELMAQDSDGVTNREVKLEVISHPAGFTNPGDGTPVWFSNPMVLAKATALKDYGSLLKLGVDFYDFEGDVLTITKTSGPDWLVLTQNSDDTWRLSGMPTAADAGENSFTFNVSDGILSSDTEIKITVDHVAGFTNLGNGAPVWSSPILNLTDGKGSFAYNYTLQLGTDYYDFEGDALTITKTSGPDWLTIQQTDANSWKLSGTPINSDAGENSFTFNLSDDTNSTTAEILINVIATIIS",
+	"ESEKIYKVMEEIFVDRHYKENIRTGEEVKQYFSKSKAEFILRWSSANESDTENKYVFIAASFQASDGIHSIRYGINKNGELFSINTASNKVTPIDILPLGVMATLTQHITQNKELIEKAL",
+	"MIANVSAHRRANAFAQALEDRESEGAAAEQTETTAEPAEQGKLLALASGLGDLPKPQLDPEVKVVQRAQLVAAMEAMLMEGSAAAAPTVPE",
+	"DRHHHHHHKLVPGTPPLFNVSLDVAPEQRWLPMLRHYDPDFLRTAVAQVIGDRVPQWVLGMVGEIVSKVESFLPQPFTDEIRSICDSLSLSLADGILVNLAYEASAF",
+	"CTSIVAQDSQGRIYHGRNLDYPFGKILRKLTADVQFIKNGQIAFTGTTFVGYVGLWTGQSPHKFTISGDERDKGWWWENMIAALSLGHSPISWLIRKTLSESESFEAAVYTLAKTPLIADVYYIVGGTSPKEGVVITRDRGGPADIWPLDPLNGEWFRVETNYDHWKPAPKVDDRRTPAIKALNATGQAHLNLETLFQVLSLFPVYNSYTIYTTVMSAAEPDKYLTMIRNPS",
+}
+
+// defaultTrainingLabels are the DSSP label strings (H, E, C, T) matching defaultTrainingSequences
+var defaultTrainingLabels = []string{
+	"CCCCCTTCCEEEEEEECCCCCCTCCTTCCCCTTCCCCEEEEEEECCTTCCCCCCEEECCCEEEEEECCCEEEEEEEEEECCHHHHHHHHHHHCCCCCCCCCCCCCCCCCHHHHHHHHHHHHCCCEEEEEEEEEEEEEEEECCCCCCCCCCCHHHHHHHHTCCCCCCTTCTTCCCCHHHHHHCTTCHHCHHCCHHHHHHHHHHHCCEEEEEEEETTEEEEEEEECHHHHHHHHHTTCCHHHHHHEHHCCCCCCCCCCCCCHHHHHHCCCCCCEEEEEEEECCCCCCCTTCHHHHHHHHHHHHHCCCCEEEEEEECCCCCCHHHHHHHHHHHHHHHHHHTCCHHHHHHCCCCCCCCCC",
+	"CCCCCCCCCCCCCHHHHHHHHHHHHHHHHHHHHCCEEEEEEECCCCCEEEEEEEECCCCCCCCCCCCEEEECTTCCEEECCCCCCCCCEEEEECCCCCTTCEEEEEEEECCCCCTTCEEEEEEECTTCCEEEEEC",
+	"CCCCCCEEEEECCCEEEEEECCCCCEEEEEEEEEEEECCTTCEEEEEEEEEEECCCCCCCHHHHHCCCEEEEEEEECCCCCHHHHHCTCCCEEEEEEEEEEECCHCHHHTTCCCCTTCEEEEEEEEEEETCEEEETTCCCCCTCCCCCCCCCCCCCCCCCEEEECCCCTEEEEEEEEECHHHHHHHHCCTTCEEEEEEEECCCCCEEEEEECTTCCEEEECEETTCEEEECCCCTTCCCTTCCCCEEEEEEETTEEEEEEETTEEEEEECCCCCCCCCCCEEEEEECCCCCC",
+	"CCCEEEEEEECCCHHHHHHHHHHHHHHHHHHHHHTTCEEEEEEEECCHHHHHHHHHTTCCCEEEECHHHHHHHHHHHCCEEEEEEEETTEEEEEEEEEEETTCCCCCCCCCTTCEEEECCTTCCHHHHHHHHHHHHTTCCCCCCCCTTCCCCCCCCEEEEECCHHHHHHHHHTTCCCEEEECHHHHHHHHTCCHHHHHTEEEEEECCCCCCCEEEEETTCCHHHHHHHHHHHHHHHTCHHHHHHHHHHHTCCCEEECCHHHHHHHHHHHHHHHHHHHHCC",
+	"CCCCCHHHHHHHHHHHHHHHHHHHHHHTTCHHHHHHHHHHHHHHHHHHHHHCCCCCCCHHHHHHHHHHHHHHHHCCCHHHHHHHHHHHHHHHHHHTTCCHHHHHHHHHHHHHHHHHHCCCCCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCCCCCCCCCCCCCCCCCCCHHHHHHHHHHHHHCHHHHHHHHHHHHHHHHHHHHHHHCTTCCHHHHHHHHHHHHHCCCCCCCCCECCCHHHHHHHHHHHHHHHHHTCHHHHHHHHHHHHHCHHHHHHHHHHHHHHHHHCCC",
+	"CCCCCCCCCEEEEECCCCEECCCCCHHHHHHHHHHHHHTTCCCCCCEEEECTTCCEEEECHHHHHHHHHHTTCCEEEEEEECCCCCEEEEEEEEECCHHHHHHHHTCCCCCCCCCCCTCCCEEEEEETTEEEEEEECCCCHHHHHHHHHHHHHHHTTCCEEECCCCCCCCCCTTCEEEECCCCCHHHHHHHHHTTCCCCTTCEEEEECCCCCCEECCCCCCCCCCCHHHHHHHHHHHHHHHTCCCCCCCEEEEECC",
+	"CCCCCCCHHHHHHHHHHHHHHHHHTTCCEEEECHHHHHHHCCCCCCCCCCEEEEEECCCHHHHHHHHHHHHHHHCCTCCCCCCETCEEEEEEEEECTTCCEEEEEEEECCCCCCCCCCCEECCCCCCEEEEETTEEEEEECHHHHHHHHHHHHHHHTCCCCHHHHHHHHHHHHHHCCTTCCCCCCTTCHHHHHHHHHHHHHCHHHHHHHHHHHCCCCCCCC",
+	"CCCEEECTTEEEEEETTEEEEEETTTTEEEEECHHHHHHHHHHHCCCCHHHHHHHHHHHHTCCHHHHHHHHHHHHHHHHHTTCEEECCCCC",
+	"CCCCCCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCCCCCCCCHHHHHHHHHHHHCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCTTCHHHHHHHHHHHHHHHHHHHHHHHHHTCHHHHHHHHHHHHHHHHHHHHHHHHCCCCCCCCHHHHHHHHHHHHHHHHHHHHCCCCCCCCCC",
+	"CCCCCCCHHHHHHHHHHHHHTTCCHHHHHHTTCCHHHHHHHHTTCCCCCHHHHHHHHHHHTCCHHHHHHHHCCCCHHHHHHHHHHHHHHTTCHHHHHHHHHHHHHHHHHCCCCCCHHHHHHHHHHHHHHHCTTCCCCHHHHHHHHHHHHHHHHTCCCCHHHHHHHHHHHHHHTCCCCCHHHHHHHHHHHHTCCCCCHHHHHHHHHHHHHHHHHHHHTTCHHHHHHHHHHHHHCCCCTCCHHHHHHHHHHHHHHHHHHCCCCCCCHHHHHHHHHHHHHHHCCHHHHHHHHHHHHHHHHHHHHHHHCC",
+	"CCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCHHHHHHHHHHHHHHTCCCCCCCCCCCCCTTCEEEEEEECCCHHHHHHHHHHHHTCTTCCCEEEEEECCCCCHHHHHHHHTCCCCEEEEEECCCCCCCCTTCCTTCCTTCCCTTCCHHHHHHTTCCCCCCCCCCCCCCCCCHHHHHHHHHHHHHHHHHHHHHCTTCCCEEEEEECCCCHHHHHHHHHHHHHHHHHHHCTTCCEEEECCCCCCCCCCCCCCEEEEEECCCCCCCEEEEEEHHHHHHHHHTHHHHCCCCCCCHHHHHHHHHHHCCCCCEEEEEECCCCEEECCCCCCCCCCCCCHHHHHHHHHHHHHHHCCCCCCCEEEEEECCCEEECCCCCCCCCCCCHHHHHHHHHHHHCC",
+	"CCCCCCEEEEECCCTCCHHHHHHHHHHHHHHHCTCCCCCCEEEEECCCCCCCTCHHHHHHHHHHHHHHCTTCEEEEEECHHHHHHHHHHHCCCCCCCCCCHHHHHHHTTCCCCCCCCCCCCCCCCCCCTTCCCCCHHHHHHHCCTTCCCCCCCCCCHHHHHHTTCCCCCHHHHHTCCHHHHHHHHTCCEEEEETTEEEECCTCCCCCCEEEEECCCCTTCCHHHHHHHHHHHCCCCCCCCCCCCCCCCCCCCCCCCTCCEEEEECCCCCCEEETTEEEEECCCCCTTCCEEEEEETTCEEEEECCCCCC",
+	"CCCCCCCCCCCCCCHHHHHHHHHHHHTCCHHHHHHHHHHHCCCCCCCCCHHHHHHHHCCCEEEECCCCHHHHHHHHHHHHHHCCCCEEEEECCCCCCCCCCCCCCCCCCCCHHHHHHHHCCHHHHHHHHHHHHHHHHHTTCCEEECCCCCCCCCTTCTTTCCCCCCCCHHHHHHHHHHHHHHHHHTTCEEEEECCTTCCCCCCCCCCCCCCCCCCHHHHHHHTHHHHHHHHHTTCCEEEECCCCCHHHHHHHCTTCCCCCCCCCCCCHHHHHHHHHHHHCCCEEEEECCCCCHHHHHHCCHHHHHHHHHHTTCCEEEECCCHHHHHHHHHHHHHTTCCCHHHHHHHHHHHHHHHHHTTCCCCCCCCCHHHHHHHHTCHHHHHHHHHHHHHCCEEEEETTTTEECCCTTTCCEEEEEEECCCCCCCCCCCHHHHHHHHHHHTTCEEEEEECCCCTTCCCCHHHHHHHCCEEEEEEEEEECCCCCEEEEECCCCCCCCCCCCCTTCCEEEEECCCHHHHCCCTTCCEEEECCCCCHHHHHHHHHHHHTCCCCCCCCCCCCCTCCCCCCCCCCCCC",
+	"CCCCCCCCCCCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHTTCCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCCCCCCCCC",
+	"CCHHHHHHHHHHHTTCHHHHHHHHHHTTCCCHHHHHHCHHHHHHHHHHHHTCCCCCCCCCCHHHHHHHHHTCCCHHHHHHHHHHCCHHHHHHHHHHHHHHHHHHHHHHCCCCHHHHHHHHHHHHHHHHHHHHHHTTCCCHHHHHHHHHHHHHHHHHHHHHHCCCCCCHHHHHHHHHHCTTCHHHHHHCCCCTCCCHHHHHHHHHCHHHHHHHHHHHCC",
+	"CCCCCCCCCCCCCCCCCCCCCCCCCCCCHHHHHHHHHHHHHHHHTTCCHHHHHHHHHHTCCCCCHHHHHHHHHHHHHHHHHHHHHHTTCCHHHHHHHTTCCCCCTTCCCEEECCCCCCCHHHHHHHHHHHHHHCCCCCCCC",
+	"CCCCCCCCHHHHHHHHHHHHHHHTCCCCCCTTCCCCCCCCCCTCCCCTTCCCCCCCTTCCCCCCCCCCCCCCCCCCCCEEEECTTTCCCCCEECCTTCCCCEEEEEECHHHHTTCCCCCCCCCCCCCHHHHHTHHHHHTTCCCCCCCCCCCCCCCCCCCCCCCCHHHHHHHHHCCCCCCCCCCCCTCCCCCCHHHHHHHHHHCCCCCCCEEEEEEECCCHHHCCCCCCCCCCCCCHHHHHHHHHHHHHHHHHHCCTTCEEEEECCCCCHHHHHHHHTCCCTTCCCCCCCCHHHHHHHHHCCCCCCCCCCCCCCHHHHHHHHHHHHHHHHHHHHHHHHCCCCCEEEEEECCCHHHHHHHHHHTTCCCCCEECCCCCCCCCHHHHHHHHHHHHHHHHHHCCCCCCCCCTTCHHHHHHHCCCCCC",
+	"CCCCCCEEEEEEECCCHHHHHHHHHHHHHHHHCTTCEEEEEECHHHHHTTCEEEECCCHHCHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCCEEEEECCCCCCHHHHHHHHHTTCCEEEEECCCCCCCCHHHHHHHHHHCCEEEECCTCCHHHHHHHTCCEEEECCCCCCCCCCCCCHHHHHHHHTCCTTCCEEEEECCCCHHHHHHHHHHHHHHHHHHHHHCTTCEEEEECCCHHHHHHHHHHHHHHCTTCCEEEECCCHHHHHHHCCEEEECCCHHHHHHHHHTCCEEEEECCCHHHHHHHHHHHTCCEEECHHHHTTCCCCHHHCCTTCCHHHHHHHHHHHHTCHHHHHHHHHHHHHHHHHHHTTCHHHHHHHHHHHHC",
+	"CCCCCCEEEEEEEEECCCCCCCTTCEEEEEEEECCCTTCCCEEEEEEEEEETTCCCCEEEEEECCCCCCCTTCEEEEEEEEEETTEEEEEECCCCCCCCCTTCCCEEEEEEEECCCC",
+	"CHHHHHHHHHTCCCHHHHHHHHHHTTTCHHHHHHHHHHHCTTCCEEECCCCCCCCCCCCCCCCCHHHHHHHHHHHHHTTCCCHHHHHHHHHHHHHTCCHHHHHHHHHHHHHCCCTTCCHHHHHHHCTTCCCCCCCC",
+	"CCCCEEEECCCCCCCHHHHHHHHHHHCCCCHHHHHHHHHHCCCCCCEEEEEEETTCEEEEEEEEEEECTTCTTEEEEEEEEECCCCTTCCHHHHHHHHHHHHHHHHHCCCEEEEECCCHHHHHHHTTCEECCCCCCCCCCCCCCCCHHHHHHHHHHHCC",
+	"CCCCCCEECCCCCEEEEECTCCCEEEECCCCEEEEEEEEEEEEEEEEECCCCCCCCCCCCCCCCCCCCCCCEEEEEECTTCCEECCCCTCCCCCCTTCCCCCEEEEETCCTTCCCEEECCCCCCCCEEECTTCEEEEEECCCCCCCCCCCCCCCEEEEEEEEEC",
+	"CCCCCCCCEEEEEECCCCCEECCCCCCCCCCHHHHHHHHHCCTTCCCCCCCCCCCCHHHHHHHHHHHHCCCCTTCCTTCCCCCCCCCHHHHHHHHHHHHCTTCEEEEEECCHHHHHHHHHHHTTCCHHHHHTCEECCCHHHHHHHHHHHHCCCCCCCEEEEEECCHHHHHHHHHHHHHTTCCHHHHHHCCCCTTCCCHHHHHHHHHHHCCCCCC",
+	"CCCCCCCCTTCCEEEEECCTCCCCCCCCCCCCCCCCCCCHHHHHHHHHHHHHCCCTTCCCCCCCCCCCCCCCC",
+	"CCCCCCCHHHHHHHHHHHHHTTCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCEEEETTEEEECCCCHHHHHHHHHHHHHHHHHHHHHCCCCCCCCTCCCCCCCCHHHHHHHHHHHHHHHHTCCCCCCCCCHHHHHHHHHHHHHHHHHHHHCCCCCCHHHHHHHHHHHHHHCCCCCCCCHHHHHHHHHHHHHHHCCCCCHHHHHHHHHHHHHHHHHHHHHHCHHHHHHHHHHHHHHHHHHCCCCCCCCTTCCCCCCCCHHHHHHHHHHHCCCCCCCCCCCHHHHHHHCCCHHHHHHHHHTCCCHHHHHHHCCCCCCCCCCCCCCCHHHHHHHHHHCCCCCCCCCCCCCCHHHHHHHHHHCCCCCCCCCCCCCCCCCCCCCCC",
+	"CCHHHHHHHHHHHHHHHCCCCCETEEEECCCCCCTTCCCCCCCCCCCCCEEEEEECCCCCCTTCECCCCTTCHHHHHHHHHHHHHTCCCCCCCTEEEEEEEEEEHHHHHCCCCCCCCCCCHHHHHHCCEEEEEEEETEEEEECCC",
+	"CCCCHHHHHHHHHHHHHHHHTCCTTCEEEEHHHHHHHTCCCCHHHHHHHHHHHHHHHHHCTTCCEEEEECTTCCEEEEECCCCCCCCCCCCCCCCC",
+	"CCCCCECCCECCTTCEECCTTCCCCCCCCCCHHEEHHCCCC",
+	"CCCCCEEECCCCEEEECCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCHHHHHHHHHHHHCHHHHHHHHHHHHHHHHCCCCCCCHHHHHHHHHHHHHCCCCCCCCECCCCCCCCCCCCCCCCHHHHHCHCCCCCCCCCCCCCCCCCCCEEEEEEEECTTCCEEEEECCTTCCEEEEEEEEECCCCCCCCCCCCTCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCTTCCCCCCCCCEEECCCCEEEECTTCCCCCCCCCCCCCCCCCCCCCCHCCCCCCCCEEEEEEEECTTCCEEEECCCCCCCCCCCCCCCCCCCCCTTCEECCCCCCEEEEEEETTCCEEEECCCCCCEEEEEECCCCCTTCCCCCCHHHHHHHHHHHTTCCCCCCCCHHHHHHHHHHHHTCCCCCCCCCHHCCCCCCCCCCCCHHHHHHHHCCCHHHHHHHHHHHHHHCCCCCCCCCCCCCCCCCCCHCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCTCCCCCCCCCCCCCCCCHHHHHHHHHHHTTCCCCCCCCCCCCCCCCCCCCCCHHHHCCCCCHHHHHHHHHHHHHCCCCCCCEEEEEEHHCCCCCCCCCCCCCCCCCCCCCCCCCCEEECCCCTTCCCCCCCCCCCCCCCHHHHHCCCCCCCCCCCCCCCCTTCHHHHHTCCCCCCCCCCCCCCCCTCCCCCCCCCCCCCCCCCCHHHHHHHHCCCCCCCCCCCCHHHHHHHCCCCHCCHHHHHHHHHHHHHHHHCCCCCCCCCCCCCCHHHHHHHHHHHHHHTTEEEECCCCCTTCCCCCCEEEEEECCCCCC",
+	"CCHHHHHHHHECCTTCCCCEEEEEEEECHHHHHHHHHHHHHCCCCHHHHHHHHHHHHHHHHHHHHHHCTTCCCCCEEEEEEEEEEEECCHHHHHHHHHHHHHHCCCCCCCCEEEEEECCCHHHHHHHHHHHHHHHHHHHHHHHCCCCCCCCHHHCHHHHHHHHHHHHHHHTTCCHHHHHHHHHTCCCHHHHHHHHCC",
+	"CCCEEEETTCEEECCHHHHHHHHCCCCCCTTCEEEEEEECCCCCCEEEEEECCCCCCCEEECCCCCCHHHHHHHHHHHHHHHHHTCCHHHHHHHCCCCCCCCCCCCTCCCCEEEEEEETTTCCEEEEECCCCCCCCCCCCCCCCCCCCEECCCHHHHHHHHHHHHHHHHHHHHHHHTTCCCCCCCCCCCHHHHHHHHHHTTCEEEEEEECCCCCCCEEEEEEECCCCCCCEEEEEECCCCHHHHHHHHHHHHHHHHHHHHTTCCCCCCTTCCCCCCCCCCCHHHHHHHHHHHCCCCCCECCCCCCCCCCCCCHHHHHHHHHHHHHHTTCCEEEEEECCCTTCCCCEEEEECTTCCCEECCTTCCCCCCCCCCCCCCC",
+	"CCCCCCCCEEEECCCTTTCCCCCCCCTTCCCCEEEEEETTEEEEECCHHHHHHHHHHHHHCEEEECCCCCHHHHHTCCCCCCCCEEECTTCEEECTTCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHTTCEEEEEEEEETTEEEEEEEECCCCCHHHHHHHHHHHHHHHCCTTEEEEEETTEEEEEETTCCHHHHHHHHHHHHHHHHCCCCEEEEECCCCCHHHHHHCCEEEECCTCHHHHHHHHHCC",
+	"CCCCCCCCCCCCCCCCTCCCHHHHCCCCCCCCCCCCCCCCCCCCCCCCCTTCCCCCEEEECCCCCCCCCCEEEEEEEEECCTTCCCEEEEEECCCCCCEEEEEEEECCTTCCCECCCEECCCCCCCCCCCTCCCCTTCCCTCCCEEEEEECCCCCCCCCCCCCCCCCCEEEEEEECCCCEEEEEEEEETTCCEEEEEEETTEEECCEEEECCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCHHHHHCCCCCCCCCEEECTTCEEECTTTCEEEEEEEEECCTTCCEEEEEEECCCCCEEEEEEECCCCCCCCCCCEEEEECCCCCEEEEECCCCCCCEEEEEEEEC",
+	"CCCCCCCCCCCCEEEEEEEEECCCCCEEEEEEETTTTEEEEECCCTTCCCCTTCCEEEEETTTCCEEEEECCCCCCEEEEEECCCCTTEEEEEETTCCEEEEETTTTEEEEEEECCCCEEEEEECCCCTTEEEEEETTCCEEEEETTCCCCCCEEEECCCCCCCEEEEEEECCCCCCCCCCCCEEEEEECEEEEEEECTTCCCEEEECCCCCCCEEEEEECTTTTEEEEEECCCCCCCCEEEEEEEEEEECCCTCCCCEEEEEEEEEECCTCCCEECCEEEEECCCCTCCEEEEEEETTTTEEEEEETTTCCEEEEEECCCCEEEEEEEEETTEEEEEEEECCEEEEEECC",
+	"CCCCCCCCCCCCCCCCCCCCCCCCCEEEECCEEECTTTTCEEEEECCCCCCCCEEEEEEECCCCCCCCCCCCCCCCCCCCCCCCEEEEEEEEEEEEEECTTCCEEEECTTCEEEEECCCCEEEEEECCCTCCCEEEEEEEEECCCCCTTCCCCCCCCCCCCEEEETTEEEEEEEEECTTCCCCEEEECCEEEEEEEECTTCEEEEECCTTCCEEEEEEECEEEETTEEECTTCEEEECTTCCEEEEEECTCCEEEEECCCCCCCCEEEEEEEECCCHHHHHHHHHHHHTTCCCCCCCTTCCCCCCCCCCCCCCCCCCC",
+	"CCCHHHHHHHHHHHHHHHHHHHCCTTCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCTCCCCEEEEECCCCCCCCCCCCCEEEEEEEEECTTCCEEEEEEEECCCCCCCCCCCCCCEEEEECCCCCCCCTCCEEEEEEEEEEEEEECCCCCCCCCEEEEEEECCCCCCCCCCCCEEEEEEEEEEEECCTTCCEEEEEEEEEEEEEEEECCCCCCCCEEEEEEECCTTCEEEEEEEEEEECCTTCTTCEEEEEEEEETTEEEEEECCCCCCCCCCCCEEEEEEEETTEEEEEEETEEEEEEECC",
+	"CCCCCCCCCTTCEEEEETTTCHHHHHHHHHHHHTTCEEEEECTTCHHHHHHHHHTTCEEEECCTTCTHHHHHTTCCCCECEEEECCCCCCCTCCHHHHHHHHHHHHHHHHHHHTTCCEEEEEECCCCCCCCCCCCCCCTTCCCCCCCCCCCCCHHHHHHHHHHHHHHHHHHTCCEEEEEECCCCCTTCCCCHHHHHHHHHHTTCCCCCCCCCCCCCCCCCHHHHHHHHHHHHHHCCCCCEEEEECCCCCEEHHHHHHHHHHHHCCCCEEEECCCCCCCCCCCHHHHHHHHCCCCCCHHHHHHHHHHHHCCCCCCC",
+	"CCCCCCCHHHHHHHHTCCCCHHHHHHHCEEEEEECCCCEEEECCTCCEEEEEEEEEEECCCTTCCCEEEEECCTTCCCCCCCCCCCCCCCEEEEEEEECCCCCCEEEEEEEECCCCTCCCEEEEECCTTCEEEEEEEEEECCCCCCCCCEEEECCCTTCCEEEECCCCCCCCEEEEEEEEETTCTTCCEEEEEEEECCCCCCCCCECCTTCCEEEECCTTCEEEEEEEEEEECCCHHHHHHCCCCCTTCCEEEEEECCCCCCCCCCCCCEEEEEEEEEECCCCCCEEEEEECCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCEEEECCEECCC",
+	"CCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHTTCCCCCHHHHHHHHHHHHHHHHHHHCCCCCCCHHHHHHHHHHHHHHHHHTHHHHHHHHHTTCHHHHHHHHHHHCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCC",
+	"CCHHHHHHHHHHHCCCCCCCCCTCCCCHHHHHHHHHHHHHHHHCCCHCCCCCCCCCCHHHHHHHHHHHHHHHHHCCHHHHHHHHHTTCHHHHHHHHHHHHHCCCHHHHHHHHHHHHHHHTCCHHHHHHHHHTCCCHHHHHHHHHCCCCHHHHHHHHHHHHHHHHHTCCCCHHHHHHCCHHHHHHHHHHHHCCCCCCCCCCCCHHHHHHHHHHHHTCCCCCCCHHHHHHHHCTTHHHHHHHHHHHHHTCCCCCEEECCCCCCCCHHHHHHHHHHTCHHHHHHCCHHHHHHHHHHHHHHHHTCCTTCHHHHHHHHHHHHHHHHCCCCCCCCCHHHHHHHHHHHHHCCCCCCCCHHHHHHHHHHHHHHHHHCCCCC",
+	"CCCCCCCCCCCCTCCCCCCCCCCCCCCCCCCCCCCCCTCCCCCCCCHHHHHHHHHHHHHCCCCCCCCCCCHHHHHHHHHCCTEEEEEECCTTCCCCCCEEEEETTEECCEEEEEEEEEEEEEECCCCEEEECCCCEEECHHHHCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCEEEEECCCEEEEEEEHHHHHHHHHHHHHHCCCCCCCCCCCCCCTTCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCC",
+	"CCCCCCCCCCCCHHHHHHHHHHHHHHHHHHHHHHHHHCCCCCCCCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCCCCCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCEEEEEEEEEHCCCCCHHHHHHHHEEEEEEEHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCCCHHHHHHHHHHHHHHHHHHHHHHHHHHHHHCCCEEEEECCCCCCCCCC",
+	"CCCCCEEEEEEETTEEEECCCCCCCCCHHHHHHHHHHCEEEETTEEEEEEECCCCTCCCEEEEECEEEEECCCCCCCEEEEEEEEETTCCCEEEEEEEETTEEEEEECCCCC",
+	"CCCCCCCHHHHHHHHHHHHHHHHHHHHHHCCCTTCHHHHHHCCECEEEEEECCCCCCCCEEECCHHHHHHHHHTCTTCCEEEEECCCCCCCCCCCCCCCHHHHHHHCCCCCTTCCCCCCCCCCCCCCCHHHHHHHHHHHTTCEEEEEEECCCCCCCCCCCCCCCCHHHHHHHHHHHCCCHHHHHHHHHCCCCCCCCCCHHHHHHHHHHHHHHHHHHCCCEEEEEEECCCCCCCCCTCCCCCCCCCCHHHHHHHHHHHHHHTCCTCEEEEECCCCCCTCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCEEEEEEECTTCCCCCCCCEEECCCCECCCCCCCCCCCCCTCCCCCCCCCCCCCCCEEEEETTCEEEECCCCEECCCCCCCEEEEEECCCCCCECCCCEEEECCCCCCCCCCCCCCCCCCCCTTCCEEEEEEECCCCCCTTCCCCEEEECCCCCCCEEEECCCCCCEEEEEECCCCCCCEEEEEEEEEECTTCCCEEEEEEEEECCCCCCCCTTCCCCEECCCCCECCCCCCEEEECEEEEEEEEEECTTCCEEEEEEECCCCEEEEEECTTCCEEEEECCCCCCCEEEEEEEEEECCCCCCCEEEEEEEECCCCCCCCCCCCCEEECCCEEEECCCCCEEEEEEEECCCCEECTTCCEEEEEEECCCTTEEEEEEETTTEEEEECCCCCCCEEEEEEEEEECCCCCCEEEEEEEECCCCCC",
+	"CHHHHHHHHHHHHHTTCCCCCCCCHHHHHHHHHCCCCCEEEEECCCCCCCCCCEEEEEEEEEEECTCEEEEEEEECTTCCEEEEETTTTEEEEEECCHHHHHHHHHHHHHHHHHHHHHCC",
+	"CCCCCCHHHHHHHHHHHHHHCCCCCCCCCCCCCCCCCHHHHHHHHHHHHHHTCCCCCCCHHHHHHHHHHHHHHHHHHHHHCCCCCCCCCCC",
+	"CCCCCCCCCCCCCCCCEEEEETTCCHHHHHHHHHHTCCHHHHHHHHHHHHHTTCCHHHHHHHHHHHHHHHHTCCCCCHHHHHHHHHHHTCCHHHHHHHHHHHHHHCC",
+	"CCEEEEECTTCCEEEEEECCCCCCHHHCTCEEEEEEEETTEEEEEEEEEHHHHHHHHHCCTTEEEEEEEECCCCCHHHHHHHHHHTTCCCHHHHHHHHHHHCCCHHHHHHHHHTCCCCCCEEEEEEECCTTEEEEEEECTTCCEEEEECCTTTCCEEEEEECCCCCCCCCCCCCTHHHHHHHHHHHCCCCCCHHHHHHHHHCCCCCCCCCEEEEEEECCCTTEEEEEECCCC",
 }
-*/
@@ -0,0 +1,523 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// PredictionMethods lists the names of the three predictors in the order they are reported
+var PredictionMethods = []string{"Chou-Fasman", "GOR", "HMM"}
+
+// StructureClasses lists the secondary structure labels that are scored, in the order they are reported
+var StructureClasses = []string{"H", "E", "C", "T"}
+
+// overallName labels the rows that summarize all evaluated residues in TSV output
+const overallName = "(overall)"
+
+// MethodLabels()
+// Input: a method name from PredictionMethods
+// Output: the label string predicted by that method, or "" for an unknown method
+func (result PredictionResult) MethodLabels(method string) string {
+	switch method {
+	case "Chou-Fasman":
+		return result.ChouFasman
+	case "GOR":
+		return result.GOR
+	case "HMM":
+		return result.HMM
+	}
+	return ""
+}
+
+// Accuracy()
+// Input: a predicted label string and the true label string of the same length
+// Output: the percentage of positions where the two strings agree (0 for empty input)
+func Accuracy(predicted, actual string) float64 {
+	if len(actual) == 0 || len(predicted) != len(actual) {
+		return 0.0
+	}
+	matches := 0
+	for i := 0; i < len(actual); i++ {
+		if predicted[i] == actual[i] {
+			matches++
+		}
+	}
+	return 100.0 * float64(matches) / float64(len(actual))
+}
+
+// ThreeState()
+// Input: a label string over H/E/T/C
+// Output: the label string with every label other than H and E replaced by C, as used for Q3
+func ThreeState(labels string) string {
+	reduced := []byte(labels)
+	for i, label := range reduced {
+		if label != 'H' && label != 'E' {
+			reduced[i] = 'C'
+		}
+	}
+	return string(reduced)
+}
+
+// ScoreLabels()
+// Input: a predicted label string and the true label string of the same length
+// Output: the residue counts for Q3, Q4, each class in StructureClasses and each pair of classes (the confusion
+// matrix), and the SOV'99 sums of each class (all zero for a length mismatch)
+func ScoreLabels(predicted, actual string) MethodScores {
+	scores := MethodScores{
+		Classes:   make(map[string]ClassCounts),
+		SOV:       SOVScores(predicted, actual, StructureClasses),
+		Confusion: newConfusionMatrix(),
+	}
+	for _, class := range StructureClasses {
+		scores.Classes[class] = ClassCounts{}
+	}
+	if len(predicted) != len(actual) {
+		return scores
+	}
+
+	predicted3, actual3 := ThreeState(predicted), ThreeState(actual)
+	scores.Residues = len(actual)
+	for i := 0; i < len(actual); i++ {
+		if predicted[i] == actual[i] {
+			scores.CorrectQ4++
+		}
+		if predicted3[i] == actual3[i] {
+			scores.CorrectQ3++
+		}
+
+		// A residue can count towards two classes: a false positive of the predicted class
+		// and a false negative of the true one
+		predictedClass, actualClass := string(predicted[i]), string(actual[i])
+		if row, ok := scores.Confusion[actualClass]; ok {
+			if _, ok := row[predictedClass]; ok {
+				row[predictedClass]++
+			}
+		}
+		if counts, ok := scores.Classes[actualClass]; ok {
+			if predictedClass == actualClass {
+				counts.TruePositives++
+			} else {
+				counts.FalseNegatives++
+			}
+			scores.Classes[actualClass] = counts
+		}
+		if counts, ok := scores.Classes[predictedClass]; ok && predictedClass != actualClass {
+			counts.FalsePositives++
+			scores.Classes[predictedClass] = counts
+		}
+	}
+	return scores
+}
+
+// Add()
+// Input: the scores of another protein
+// Output: the sum of the two sets of counts, used to score a whole dataset
+func (s MethodScores) Add(other MethodScores) MethodScores {
+	sum := MethodScores{
+		Residues:  s.Residues + other.Residues,
+		CorrectQ3: s.CorrectQ3 + other.CorrectQ3,
+		CorrectQ4: s.CorrectQ4 + other.CorrectQ4,
+		Classes:   make(map[string]ClassCounts),
+		SOV:       make(map[string]SOVCounts),
+		Confusion: newConfusionMatrix(),
+	}
+	for _, classes := range []map[string]ClassCounts{s.Classes, other.Classes} {
+		for class, counts := range classes {
+			total := sum.Classes[class]
+			total.TruePositives += counts.TruePositives
+			total.FalsePositives += counts.FalsePositives
+			total.FalseNegatives += counts.FalseNegatives
+			sum.Classes[class] = total
+		}
+	}
+	for _, sov := range []map[string]SOVCounts{s.SOV, other.SOV} {
+		for class, counts := range sov {
+			total := sum.SOV[class]
+			total.Sum += counts.Sum
+			total.Length += counts.Length
+			sum.SOV[class] = total
+		}
+	}
+	for _, confusion := range []map[string]map[string]int{s.Confusion, other.Confusion} {
+		for actual, row := range confusion {
+			for predicted, count := range row {
+				sum.Confusion[actual][predicted] += count
+			}
+		}
+	}
+	return sum
+}
+
+// newConfusionMatrix returns a zero count for every pair of classes in StructureClasses
+func newConfusionMatrix() map[string]map[string]int {
+	confusion := make(map[string]map[string]int)
+	for _, actual := range StructureClasses {
+		confusion[actual] = make(map[string]int)
+		for _, predicted := range StructureClasses {
+			confusion[actual][predicted] = 0
+		}
+	}
+	return confusion
+}
+
+// Q3 returns the percentage of residues predicted correctly over the three states H/E/C
+func (s MethodScores) Q3() float64 {
+	return percentage(s.CorrectQ3, s.Residues)
+}
+
+// Q4 returns the percentage of residues predicted correctly over the four states H/E/T/C
+func (s MethodScores) Q4() float64 {
+	return percentage(s.CorrectQ4, s.Residues)
+}
+
+// Precision returns TP / (TP + FP) as a percentage, or NaN if the class was never predicted
+func (c ClassCounts) Precision() float64 {
+	return ratio(c.TruePositives, c.TruePositives+c.FalsePositives)
+}
+
+// Recall returns TP / (TP + FN) as a percentage, or NaN if the class never occurs
+func (c ClassCounts) Recall() float64 {
+	return ratio(c.TruePositives, c.TruePositives+c.FalseNegatives)
+}
+
+// F1 returns the harmonic mean of precision and recall, or NaN if either is undefined or both are 0
+func (c ClassCounts) F1() float64 {
+	precision, recall := c.Precision(), c.Recall()
+	if math.IsNaN(precision) || math.IsNaN(recall) || precision+recall == 0 {
+		return math.NaN()
+	}
+	return 2 * precision * recall / (precision + recall)
+}
+
+// MCC()
+// Input: a class in StructureClasses
+// Output: the Matthews correlation coefficient of the class, from -1 to 1, treating every other residue
+// as a negative. NaN if the class is never observed or never predicted, or covers every residue.
+func (s MethodScores) MCC(class string) float64 {
+	counts := s.Classes[class]
+	tp := float64(counts.TruePositives)
+	fp := float64(counts.FalsePositives)
+	fn := float64(counts.FalseNegatives)
+	tn := float64(s.Residues) - tp - fp - fn
+	denominator := math.Sqrt((tp + fp) * (tp + fn) * (tn + fp) * (tn + fn))
+	if denominator == 0 {
+		return math.NaN()
+	}
+	return (tp*tn - fp*fn) / denominator
+}
+
+// percentage returns 100 * part / total, or 0 when total is 0
+func percentage(part, total int) float64 {
+	if total == 0 {
+		return 0.0
+	}
+	return 100.0 * float64(part) / float64(total)
+}
+
+// ratio returns 100 * part / total, or NaN when total is 0
+func ratio(part, total int) float64 {
+	if total == 0 {
+		return math.NaN()
+	}
+	return 100.0 * float64(part) / float64(total)
+}
+
+// EvaluateProteins()
+// Input: a Predictor, the labeled proteins to evaluate and the reduction scheme applied to both the true
+// and the predicted labels before scoring
+// Output: one ProteinEvaluation per protein. Proteins whose sequence and labels differ in length,
+// or that cannot be predicted, are returned with the Error field set.
+func EvaluateProteins(p *Predictor, proteins []LabeledProtein, scheme ReductionScheme) []ProteinEvaluation {
+	evaluations := make([]ProteinEvaluation, len(proteins))
+	for i, protein := range proteins {
+		evaluation := ProteinEvaluation{
+			Name:   protein.Name,
+			Length: len(protein.Sequence),
+			Scores: make(map[string]MethodScores),
+		}
+
+		if len(protein.Sequence) != len(protein.Labels) {
+			evaluation.Error = fmt.Sprintf("sequence length %d does not match label length %d", len(protein.Sequence), len(protein.Labels))
+			evaluations[i] = evaluation
+			continue
+		}
+
+		result := p.Predict(FASTARecord{ID: protein.Name, Sequence: protein.Sequence})
+		if result.Error != "" {
+			evaluation.Error = result.Error
+			evaluations[i] = evaluation
+			continue
+		}
+
+		actual := scheme.Reduce(protein.Labels)
+		for _, method := range PredictionMethods {
+			evaluation.Scores[method] = ScoreLabels(scheme.Reduce(result.MethodLabels(method)), actual)
+		}
+		evaluations[i] = evaluation
+	}
+	return evaluations
+}
+
+// OverallScores()
+// Input: the per-protein evaluations
+// Output: the counts of each method summed over every protein that was evaluated
+func OverallScores(evaluations []ProteinEvaluation) map[string]MethodScores {
+	overall := make(map[string]MethodScores)
+	for _, method := range PredictionMethods {
+		overall[method] = ScoreLabels("", "") // Start with zero counts for every class
+	}
+	for _, evaluation := range evaluations {
+		if evaluation.Error != "" {
+			continue
+		}
+		for _, method := range PredictionMethods {
+			overall[method] = overall[method].Add(evaluation.Scores[method])
+		}
+	}
+	return overall
+}
+
+// WriteEvaluation()
+// Input: a writer, the output format (text, json or tsv) and the per-protein evaluations
+// Output: an error if the format is unknown or writing fails
+func WriteEvaluation(w io.Writer, format string, evaluations []ProteinEvaluation) error {
+	switch format {
+	case FormatText:
+		return WriteTextEvaluation(w, evaluations)
+	case FormatJSON:
+		return WriteJSONEvaluation(w, evaluations)
+	case FormatTSV:
+		return WriteTSVEvaluation(w, evaluations)
+	default:
+		return fmt.Errorf("unknown output format %q (expected %s, %s or %s)", format, FormatText, FormatJSON, FormatTSV)
+	}
+}
+
+// WriteTextEvaluation()
+// Input: a writer and the per-protein evaluations
+// Output: an error if writing fails
+// Prints the Q3 and Q4 accuracy of every method per protein, their mean over the proteins and over all
+// residues, followed by the precision, recall, F1 score, SOV'99 and Matthews correlation of each class and
+// the confusion matrix of every method over all residues.
+func WriteTextEvaluation(w io.Writer, evaluations []ProteinEvaluation) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-12s %6s", "Protein", "Length")
+	for _, method := range PredictionMethods {
+		fmt.Fprintf(&b, " %15s %15s", method+" Q3", method+" Q4")
+	}
+	b.WriteString("\n")
+
+	totalQ3 := make(map[string]float64)
+	totalQ4 := make(map[string]float64)
+	evaluated := 0
+	for _, evaluation := range evaluations {
+		if evaluation.Error != "" {
+			fmt.Fprintf(&b, "%-12s %6d skipped: %s\n", evaluation.Name, evaluation.Length, evaluation.Error)
+			continue
+		}
+		evaluated++
+		fmt.Fprintf(&b, "%-12s %6d", evaluation.Name, evaluation.Length)
+		for _, method := range PredictionMethods {
+			scores := evaluation.Scores[method]
+			fmt.Fprintf(&b, " %14.2f%% %14.2f%%", scores.Q3(), scores.Q4())
+			totalQ3[method] += scores.Q3()
+			totalQ4[method] += scores.Q4()
+		}
+		b.WriteString("\n")
+	}
+
+	if evaluated > 0 {
+		// Mean of the per-protein accuracies, then the accuracy over all residues
+		overall := OverallScores(evaluations)
+		fmt.Fprintf(&b, "%-12s %6d", "Average", evaluated)
+		for _, method := range PredictionMethods {
+			fmt.Fprintf(&b, " %14.2f%% %14.2f%%", totalQ3[method]/float64(evaluated), totalQ4[method]/float64(evaluated))
+		}
+		b.WriteString("\n")
+		fmt.Fprintf(&b, "%-12s %6d", "Overall", overall[PredictionMethods[0]].Residues)
+		for _, method := range PredictionMethods {
+			fmt.Fprintf(&b, " %14.2f%% %14.2f%%", overall[method].Q3(), overall[method].Q4())
+		}
+		b.WriteString("\n")
+
+		fmt.Fprintf(&b, "\nPer-class metrics over all residues (%%):\n")
+		fmt.Fprintf(&b, "%-12s %5s %10s %10s %10s %10s %10s\n", "Method", "Class", "Precision", "Recall", "F1", "SOV", "MCC")
+		for _, method := range PredictionMethods {
+			for _, class := range StructureClasses {
+				counts := overall[method].Classes[class]
+				fmt.Fprintf(&b, "%-12s %5s %10s %10s %10s %10s %10s\n", method, class,
+					formatMetric(counts.Precision()), formatMetric(counts.Recall()), formatMetric(counts.F1()),
+					formatMetric(overall[method].SOV[class].Score()), formatMCC(overall[method].MCC(class)))
+			}
+			fmt.Fprintf(&b, "%-12s %5s %10s %10s %10s %10s\n", method, "all", "", "", "", formatMetric(OverallSOV(overall[method].SOV)))
+		}
+
+		// Confusion matrices of the three methods side by side
+		fmt.Fprintf(&b, "\nConfusion matrices over all residues (rows: true label, columns: predicted label):\n")
+		methodHeader := fmt.Sprintf("%-5s", "")
+		for _, method := range PredictionMethods {
+			methodHeader += fmt.Sprintf("   %-*s", 7*len(StructureClasses), method)
+		}
+		b.WriteString(strings.TrimRight(methodHeader, " ") + "\n")
+		fmt.Fprintf(&b, "%-5s", "True")
+		for range PredictionMethods {
+			b.WriteString("   ")
+			for _, predicted := range StructureClasses {
+				fmt.Fprintf(&b, "%7s", predicted)
+			}
+		}
+		b.WriteString("\n")
+		for _, actual := range StructureClasses {
+			fmt.Fprintf(&b, "%-5s", actual)
+			for _, method := range PredictionMethods {
+				b.WriteString("   ")
+				for _, predicted := range StructureClasses {
+					fmt.Fprintf(&b, "%7d", overall[method].Confusion[actual][predicted])
+				}
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// formatMetric prints a percentage with two decimals, or NA when it is undefined
+func formatMetric(value float64) string {
+	if math.IsNaN(value) {
+		return "NA"
+	}
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
+
+// formatMCC prints a correlation coefficient with three decimals, or NA when it is undefined
+func formatMCC(value float64) string {
+	if math.IsNaN(value) {
+		return "NA"
+	}
+	return strconv.FormatFloat(value, 'f', 3, 64)
+}
+
+// NewEvaluationReport()
+// Input: the per-protein evaluations
+// Output: an EvaluationReport with the accuracy and per-class metrics of every protein and over all residues
+func NewEvaluationReport(evaluations []ProteinEvaluation) EvaluationReport {
+	report := EvaluationReport{
+		Proteins: make([]ProteinReport, len(evaluations)),
+		Overall:  make(map[string]MethodReport),
+	}
+	for i, evaluation := range evaluations {
+		protein := ProteinReport{Name: evaluation.Name, Length: evaluation.Length, Error: evaluation.Error}
+		if evaluation.Error == "" {
+			protein.Methods = make(map[string]MethodReport)
+			for _, method := range PredictionMethods {
+				protein.Methods[method] = newMethodReport(evaluation.Scores[method])
+			}
+		}
+		report.Proteins[i] = protein
+	}
+	for method, scores := range OverallScores(evaluations) {
+		report.Overall[method] = newMethodReport(scores)
+	}
+	return report
+}
+
+// newMethodReport converts residue counts into percentages, leaving undefined metrics as null
+func newMethodReport(scores MethodScores) MethodReport {
+	optional := func(value float64) *float64 {
+		if math.IsNaN(value) {
+			return nil
+		}
+		return &value
+	}
+	report := MethodReport{
+		Q3:        scores.Q3(),
+		Q4:        scores.Q4(),
+		SOV:       optional(OverallSOV(scores.SOV)),
+		Classes:   make(map[string]ClassReport),
+		Confusion: scores.Confusion,
+	}
+	for _, class := range StructureClasses {
+		counts := scores.Classes[class]
+		report.Classes[class] = ClassReport{
+			Precision: optional(counts.Precision()),
+			Recall:    optional(counts.Recall()),
+			F1:        optional(counts.F1()),
+			SOV:       optional(scores.SOV[class].Score()),
+			MCC:       optional(scores.MCC(class)),
+		}
+	}
+	return report
+}
+
+// WriteJSONEvaluation()
+// Input: a writer and the per-protein evaluations
+// Output: an error if encoding fails
+// Writes the EvaluationReport as indented JSON.
+func WriteJSONEvaluation(w io.Writer, evaluations []ProteinEvaluation) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(NewEvaluationReport(evaluations))
+}
+
+// WriteTSVEvaluation()
+// Input: a writer and the per-protein evaluations
+// Output: an error if writing fails
+// Writes one row per protein and method with Q3, Q4 and SOV, then the precision, recall, F1, segment overlap
+// and MCC of each class and the confusion matrix as n_<true>_<predicted> columns, followed by one "(overall)"
+// row per method over all residues. Undefined metrics are written as NA and proteins that were not evaluated
+// are omitted.
+func WriteTSVEvaluation(w io.Writer, evaluations []ProteinEvaluation) error {
+	header := []string{"protein", "method", "residues", "q3", "q4", "sov"}
+	for _, class := range StructureClasses {
+		lower := strings.ToLower(class)
+		header = append(header, "precision_"+lower, "recall_"+lower, "f1_"+lower, "sov_"+lower, "mcc_"+lower)
+	}
+	for _, actual := range StructureClasses {
+		for _, predicted := range StructureClasses {
+			header = append(header, "n_"+strings.ToLower(actual)+"_"+strings.ToLower(predicted))
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.Join(header, "\t") + "\n")
+	writeRow := func(name, method string, scores MethodScores) {
+		row := []string{name, method, strconv.Itoa(scores.Residues), formatMetric(scores.Q3()), formatMetric(scores.Q4()), formatMetric(OverallSOV(scores.SOV))}
+		for _, class := range StructureClasses {
+			counts := scores.Classes[class]
+			row = append(row, formatMetric(counts.Precision()), formatMetric(counts.Recall()), formatMetric(counts.F1()),
+				formatMetric(scores.SOV[class].Score()), formatMCC(scores.MCC(class)))
+		}
+		for _, actual := range StructureClasses {
+			for _, predicted := range StructureClasses {
+				row = append(row, strconv.Itoa(scores.Confusion[actual][predicted]))
+			}
+		}
+		b.WriteString(strings.Join(row, "\t") + "\n")
+	}
+
+	for _, evaluation := range evaluations {
+		if evaluation.Error != "" {
+			continue
+		}
+		for _, method := range PredictionMethods {
+			writeRow(evaluation.Name, method, evaluation.Scores[method])
+		}
+	}
+	overall := OverallScores(evaluations)
+	for _, method := range PredictionMethods {
+		writeRow(overallName, method, overall[method])
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,168 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestAccuracy(t *testing.T) {
+	tests := []struct {
+		name      string  // Name of the test case
+		predicted string  // Predicted labels
+		actual    string  // True labels
+		expected  float64 // Expected percentage
+	}{
+		{name: "All correct", predicted: "HHEC", actual: "HHEC", expected: 100},
+		{name: "Half correct", predicted: "HHHH", actual: "HHCC", expected: 50},
+		{name: "Length mismatch", predicted: "HHH", actual: "HH", expected: 0},
+		{name: "Empty", predicted: "", actual: "", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Accuracy(tt.predicted, tt.actual)
+			if !floatEquals(result, tt.expected, 1e-9) {
+				t.Errorf("Test %s failed. Expected %v but got %v", tt.name, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestThreeState(t *testing.T) {
+	if result := ThreeState("HETCGB"); result != "HECCCC" {
+		t.Errorf("Expected HECCCC but got %s", result)
+	}
+}
+
+func TestScoreLabels(t *testing.T) {
+	// Q4 counts 3 of 6 residues; reducing T to C adds the T/C mismatch at position 5
+	scores := ScoreLabels("HHECTC", "HHHCCT")
+	if scores.Residues != 6 || scores.CorrectQ4 != 3 || scores.CorrectQ3 != 5 {
+		t.Errorf("Unexpected counts %+v", scores)
+	}
+	if !floatEquals(scores.Q4(), 50, 1e-9) || !floatEquals(scores.Q3(), 500.0/6, 1e-9) {
+		t.Errorf("Expected Q4 50 and Q3 83.33 but got %v and %v", scores.Q4(), scores.Q3())
+	}
+
+	expected := map[string]ClassCounts{
+		"H": {TruePositives: 2, FalseNegatives: 1},
+		"E": {FalsePositives: 1},
+		"C": {TruePositives: 1, FalsePositives: 1, FalseNegatives: 1},
+		"T": {FalsePositives: 1, FalseNegatives: 1},
+	}
+	for class, counts := range expected {
+		if scores.Classes[class] != counts {
+			t.Errorf("Class %s: expected %+v but got %+v", class, counts, scores.Classes[class])
+		}
+	}
+
+	helix := scores.Classes["H"]
+	if !floatEquals(helix.Precision(), 100, 1e-9) || !floatEquals(helix.Recall(), 200.0/3, 1e-9) || !floatEquals(helix.F1(), 80, 1e-9) {
+		t.Errorf("Unexpected helix metrics %v %v %v", helix.Precision(), helix.Recall(), helix.F1())
+	}
+	// E never occurs, so its recall and F1 are undefined; its precision is 0
+	strand := scores.Classes["E"]
+	if strand.Precision() != 0 || !math.IsNaN(strand.Recall()) || !math.IsNaN(strand.F1()) {
+		t.Errorf("Unexpected strand metrics %v %v %v", strand.Precision(), strand.Recall(), strand.F1())
+	}
+}
+
+func TestOverallScores(t *testing.T) {
+	evaluations := []ProteinEvaluation{
+		{Name: "p1", Scores: map[string]MethodScores{"Chou-Fasman": ScoreLabels("HH", "HH"), "GOR": ScoreLabels("HH", "HH"), "HMM": ScoreLabels("HH", "HH")}},
+		{Name: "p2", Scores: map[string]MethodScores{"Chou-Fasman": ScoreLabels("EEEEEE", "HHHHHH"), "GOR": ScoreLabels("HH", "EE"), "HMM": ScoreLabels("HH", "HH")}},
+		{Name: "p3", Error: "skipped"},
+	}
+	overall := OverallScores(evaluations)
+
+	// Pooled over residues, so the longer protein weighs more
+	if cf := overall["Chou-Fasman"]; cf.Residues != 8 || !floatEquals(cf.Q4(), 25, 1e-9) {
+		t.Errorf("Unexpected Chou-Fasman totals %+v", cf)
+	}
+	if gor := overall["GOR"].Classes["H"]; gor != (ClassCounts{TruePositives: 2, FalsePositives: 2}) {
+		t.Errorf("Unexpected GOR helix counts %+v", gor)
+	}
+}
+
+func TestWriteEvaluationFormats(t *testing.T) {
+	evaluations := []ProteinEvaluation{
+		{Name: "p1", Length: 4, Scores: map[string]MethodScores{"Chou-Fasman": ScoreLabels("HHCC", "HHCC"), "GOR": ScoreLabels("HECC", "HHCC"), "HMM": ScoreLabels("CCCC", "HHCC")}},
+		{Name: "p2", Length: 3, Error: "sequence length 3 does not match label length 2"},
+	}
+
+	var text bytes.Buffer
+	if err := WriteEvaluation(&text, FormatText, evaluations); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, want := range []string{"p2", "skipped", "Overall", "Per-class metrics", "NA", "Confusion matrices"} {
+		if !strings.Contains(text.String(), want) {
+			t.Errorf("Text output is missing %q:\n%s", want, text.String())
+		}
+	}
+
+	var tsv bytes.Buffer
+	if err := WriteEvaluation(&tsv, FormatTSV, evaluations); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(tsv.String()), "\n")
+	if len(lines) != 1+3+3 || !strings.HasPrefix(lines[2], "p1\tGOR\t4\t75.00\t75.00\t75.00\t100.00\t50.00\t66.67\t50.00\t0.577\t0.00\tNA\tNA\tNA\tNA\t") {
+		t.Errorf("Unexpected TSV output:\n%s", tsv.String())
+	}
+
+	var js bytes.Buffer
+	if err := WriteEvaluation(&js, FormatJSON, evaluations); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var report EvaluationReport
+	if err := json.Unmarshal(js.Bytes(), &report); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+	if len(report.Proteins) != 2 || report.Proteins[1].Error == "" || report.Overall["HMM"].Q4 != 50 ||
+		report.Overall["HMM"].Classes["H"].Precision != nil || report.Overall["HMM"].Confusion["H"]["C"] != 2 {
+		t.Errorf("Unexpected report %+v", report)
+	}
+
+	if err := WriteEvaluation(&js, "xml", evaluations); err == nil {
+		t.Errorf("Expected an error for an unknown format")
+	}
+}
+
+func TestConfusionAndMCC(t *testing.T) {
+	scores := ScoreLabels("HHECTC", "HHHCCT")
+	expected := map[string]map[string]int{
+		"H": {"H": 2, "E": 1},
+		"C": {"C": 1, "T": 1},
+		"T": {"C": 1},
+	}
+	for _, actual := range StructureClasses {
+		for _, predicted := range StructureClasses {
+			if scores.Confusion[actual][predicted] != expected[actual][predicted] {
+				t.Errorf("Confusion[%s][%s]: expected %d but got %d", actual, predicted, expected[actual][predicted], scores.Confusion[actual][predicted])
+			}
+		}
+	}
+
+	// Helix: TP 2, FP 0, FN 1, TN 3
+	if mcc := scores.MCC("H"); !floatEquals(mcc, 6/math.Sqrt(2*3*3*4), 1e-9) {
+		t.Errorf("Expected helix MCC %v but got %v", 6/math.Sqrt(2*3*3*4), mcc)
+	}
+	// Strand is never observed, so its MCC is undefined
+	if mcc := scores.MCC("E"); !math.IsNaN(mcc) {
+		t.Errorf("Expected NaN for strand MCC but got %v", mcc)
+	}
+	if mcc := ScoreLabels("HHCC", "CCHH").MCC("H"); !floatEquals(mcc, -1, 1e-9) {
+		t.Errorf("Expected MCC -1 for an inverted prediction but got %v", mcc)
+	}
+
+	// Confusion matrices add up over proteins
+	sum := scores.Add(scores)
+	if sum.Confusion["H"]["H"] != 4 || sum.Confusion["T"]["C"] != 2 {
+		t.Errorf("Unexpected summed confusion matrix %v", sum.Confusion)
+	}
+}
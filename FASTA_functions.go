@@ -0,0 +1,93 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ReadFASTA()
+// Input: an io.Reader containing one or more FASTA records
+// Output: a slice of FASTARecord values in file order, or an error if the input is malformed
+// Sequence lines are concatenated with whitespace removed. Blank lines and ';' comment lines are ignored.
+func ReadFASTA(r io.Reader) ([]FASTARecord, error) {
+	var records []FASTARecord
+	var current *FASTARecord
+	var seq strings.Builder
+
+	// flush stores the record being built, if any
+	flush := func() {
+		if current != nil {
+			current.Sequence = seq.String()
+			records = append(records, *current)
+			seq.Reset()
+		}
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024) // Allow long single-line sequences
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, ";") {
+			continue // Skip blank lines and old-style comments
+		}
+
+		if strings.HasPrefix(line, ">") {
+			flush()
+			header := strings.TrimSpace(line[1:])
+			if header == "" {
+				return nil, fmt.Errorf("line %d: FASTA header has no identifier", lineNum)
+			}
+			id, description := header, ""
+			if idx := strings.IndexAny(header, " \t"); idx >= 0 {
+				id = header[:idx]
+				description = strings.TrimSpace(header[idx+1:])
+			}
+			current = &FASTARecord{ID: id, Description: description}
+			continue
+		}
+
+		if current == nil {
+			return nil, fmt.Errorf("line %d: sequence data found before the first FASTA header", lineNum)
+		}
+		seq.WriteString(strings.Join(strings.Fields(line), ""))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read FASTA input: %v", err)
+	}
+	flush()
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no FASTA records found")
+	}
+	return records, nil
+}
+
+// ReadFASTAFile()
+// Input: the path of a FASTA file, or "-" to read from standard input
+// Output: the FASTA records contained in the file, or an error
+func ReadFASTAFile(filename string) ([]FASTARecord, error) {
+	if filename == "-" {
+		return ReadFASTA(os.Stdin)
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	records, err := ReadFASTA(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return records, nil
+}
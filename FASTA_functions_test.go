@@ -0,0 +1,76 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadFASTA(t *testing.T) {
+	tests := []struct {
+		name     string        // Name of the test case
+		input    string        // FASTA text
+		expected []FASTARecord // Expected records
+		wantErr  bool          // Whether an error is expected
+	}{
+		{
+			name:  "Single record",
+			input: ">5LOSA\nGPGSAPLPNP\n",
+			expected: []FASTARecord{
+				{ID: "5LOSA", Sequence: "GPGSAPLPNP"},
+			},
+		},
+		{
+			name:  "Multiple records with wrapped lines and descriptions",
+			input: ">sp|P1|ONE first protein\nMKT\nAYI\n\n>P2\nGG GA\n;comment\nVV\n",
+			expected: []FASTARecord{
+				{ID: "sp|P1|ONE", Description: "first protein", Sequence: "MKTAYI"},
+				{ID: "P2", Sequence: "GGGAVV"},
+			},
+		},
+		{
+			name:  "Empty record is kept",
+			input: ">empty\n>P3\nAC\n",
+			expected: []FASTARecord{
+				{ID: "empty"},
+				{ID: "P3", Sequence: "AC"},
+			},
+		},
+		{
+			name:    "Sequence before header",
+			input:   "MKT\n>P1\nAAA\n",
+			wantErr: true,
+		},
+		{
+			name:    "Header without identifier",
+			input:   ">\nAAA\n",
+			wantErr: true,
+		},
+		{
+			name:    "No records",
+			input:   "\n\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ReadFASTA(strings.NewReader(tt.input))
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Test %s failed. Expected an error but got %v", tt.name, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Test %s failed with error: %v", tt.name, err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Test %s failed. Expected %v but got %v", tt.name, tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// gorConstantsFile is the file name gor-train writes fitted decision constants to
+const gorConstantsFile = "DecisionConstants.csv"
+
+// gorConstantsHeader is the header of a decision constants file
+var gorConstantsHeader = []string{"Structure", "Constant"}
+
+// values returns the constants in the order of gorStructures (H, E, T, C)
+func (c GORDecisionConstants) values() [4]float64 {
+	return [4]float64{c.Alpha, c.Beta, c.Turn, c.Coil}
+}
+
+// gorDecisionConstants builds the constants from values in the order of gorStructures
+func gorDecisionConstants(values [4]float64) GORDecisionConstants {
+	return GORDecisionConstants{Alpha: values[0], Beta: values[1], Turn: values[2], Coil: values[3]}
+}
+
+// gorScores returns the four scores of a residue in the order of gorStructures
+func gorScores(p GORPredictionResult) [4]float64 {
+	return [4]float64{p.ScoreAlpha, p.ScoreBeta, p.ScoreTurn, p.ScoreCoil}
+}
+
+/*
+	ApplyGORDecisionConstants adds the decision constant of each structure to its score and predicts the structure with
+	the highest sum, as in the original GOR method, so a systematic offset between the tables does not bias the
+	predictions toward one class.
+
+Input: the per-residue GOR predictions (changed in place) and the decision constants in centinats.
+Output: none; zero constants leave the predictions unchanged.
+*/
+func ApplyGORDecisionConstants(predictions []GORPredictionResult, constants GORDecisionConstants) {
+	if constants == (GORDecisionConstants{}) {
+		return
+	}
+	for i := range predictions {
+		p := &predictions[i]
+		p.ScoreAlpha += constants.Alpha
+		p.ScoreBeta += constants.Beta
+		p.ScoreTurn += constants.Turn
+		p.ScoreCoil += constants.Coil
+		p.PredictedStructure = gorStructure(p.ScoreAlpha, p.ScoreBeta, p.ScoreTurn, p.ScoreCoil)
+	}
+}
+
+/*
+	LabelComposition returns the fraction of residues of each GOR structure class in a labeled dataset.
+
+Input: labeled proteins whose labels are among H, E, T and C.
+Output: the fractions of H, E, T and C (in the order of gorStructures), or an error if a label is not one of the
+four classes or there are no residues.
+*/
+func LabelComposition(proteins []LabeledProtein) ([4]float64, error) {
+	var counts [4]float64
+	total := 0.0
+	for _, protein := range proteins {
+		for i := 0; i < len(protein.Labels); i++ {
+			k := strings.IndexByte(string(gorStructures), protein.Labels[i])
+			if k < 0 {
+				return [4]float64{}, fmt.Errorf("protein %s: label %q at position %d is not one of H, E, T and C", protein.Name, protein.Labels[i], i+1)
+			}
+			counts[k]++
+			total++
+		}
+	}
+	if total == 0 {
+		return [4]float64{}, fmt.Errorf("no labeled residues")
+	}
+	for k := range counts {
+		counts[k] /= total
+	}
+	return counts, nil
+}
+
+/*
+	FitGORDecisionConstants finds decision constants for which GOR predicts each structure class in the given
+	proportions.
+
+Input: the per-residue GOR predictions (scores without constants) pooled over a training set, and the target fraction
+of H, E, T and C (e.g. from LabelComposition), which should sum to 1.
+Output: the decision constants in centinats, shifted so that the coil constant is 0, or an error if there are no
+predictions or a fraction is negative.
+
+The constants are fitted one class at a time: with the other constants fixed, class k wins residue i exactly when its
+constant exceeds the threshold max_j(s_ij + c_j) - s_ik, so sorting the thresholds gives the constant that makes class
+k win its target number of residues. The classes are cycled until the constants stop changing.
+*/
+func FitGORDecisionConstants(predictions []GORPredictionResult, composition [4]float64) (GORDecisionConstants, error) {
+	n := len(predictions)
+	if n == 0 {
+		return GORDecisionConstants{}, fmt.Errorf("no predictions to fit the decision constants to")
+	}
+	var targets [4]int
+	for k, fraction := range composition {
+		if fraction < 0 || math.IsNaN(fraction) {
+			return GORDecisionConstants{}, fmt.Errorf("invalid fraction %v for %c", fraction, gorStructures[k])
+		}
+		targets[k] = int(math.Round(fraction * float64(n)))
+	}
+
+	scores := make([][4]float64, n)
+	for i, p := range predictions {
+		scores[i] = gorScores(p)
+	}
+
+	var constants [4]float64
+	thresholds := make([]float64, n)
+	for iteration := 0; iteration < 100; iteration++ {
+		changed := false
+		for k := range constants {
+			for i, s := range scores {
+				best := math.Inf(-1) // Highest competing score with its constant
+				for j := range s {
+					if j != k && s[j]+constants[j] > best {
+						best = s[j] + constants[j]
+					}
+				}
+				thresholds[i] = best - s[k]
+			}
+			sort.Float64s(thresholds)
+
+			// Place the constant between the thresholds of the last residue class k should win and the first it should not
+			var c float64
+			switch m := targets[k]; {
+			case m <= 0:
+				c = thresholds[0] - 1
+			case m >= n:
+				c = thresholds[n-1] + 1
+			default:
+				c = (thresholds[m-1] + thresholds[m]) / 2
+			}
+			if math.Abs(c-constants[k]) > 1e-9 {
+				changed = true
+			}
+			constants[k] = c
+		}
+		if !changed {
+			break
+		}
+	}
+
+	// Only differences between the constants matter
+	coil := constants[3]
+	for k := range constants {
+		constants[k] -= coil
+	}
+	return gorDecisionConstants(constants), nil
+}
+
+/*
+	ParseGORDecisionConstants reads decision constants from a CSV with the header Structure,Constant and one row for
+	each of H, E, T and C.
+
+Input: an io.Reader with the CSV.
+Output: the decision constants in centinats, or an error if the header or a row is malformed or a class is missing.
+*/
+func ParseGORDecisionConstants(r io.Reader) (GORDecisionConstants, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1 // Row lengths are checked below with a clearer message
+
+	header, err := reader.Read()
+	if err != nil {
+		return GORDecisionConstants{}, fmt.Errorf("failed to read header: %v", err)
+	}
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	if strings.Join(header, ",") != strings.Join(gorConstantsHeader, ",") {
+		return GORDecisionConstants{}, fmt.Errorf("header must be %s, got %s", strings.Join(gorConstantsHeader, ","), strings.Join(header, ","))
+	}
+
+	var values [4]float64
+	seen := make(map[byte]bool)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break // End of file reached
+		}
+		if err != nil {
+			return GORDecisionConstants{}, fmt.Errorf("failed to read record: %v", err)
+		}
+		if len(record) != len(gorConstantsHeader) {
+			return GORDecisionConstants{}, fmt.Errorf("row %s has %d values, expected 1", record[0], len(record)-1)
+		}
+
+		structure := strings.ToUpper(record[0])
+		k := strings.Index(string(gorStructures), structure)
+		if len(structure) != 1 || k < 0 {
+			return GORDecisionConstants{}, fmt.Errorf("unknown structure %q: expected H, E, T or C", record[0])
+		}
+		if seen[structure[0]] {
+			return GORDecisionConstants{}, fmt.Errorf("duplicate row for %s", structure)
+		}
+		value, err := strconv.ParseFloat(record[1], 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			return GORDecisionConstants{}, fmt.Errorf("row %s: invalid value %q", structure, record[1])
+		}
+		seen[structure[0]] = true
+		values[k] = value
+	}
+
+	var missing []string
+	for _, s := range gorStructures {
+		if !seen[s] {
+			missing = append(missing, string(s))
+		}
+	}
+	if len(missing) > 0 {
+		return GORDecisionConstants{}, fmt.Errorf("missing rows for %s", strings.Join(missing, ", "))
+	}
+	return gorDecisionConstants(values), nil
+}
+
+// ReadGORDecisionConstants()
+// Input: the path of a decision constants file (see ParseGORDecisionConstants)
+// Output: the decision constants, or an error naming the file
+func ReadGORDecisionConstants(filename string) (GORDecisionConstants, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return GORDecisionConstants{}, fmt.Errorf("failed to open file %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	constants, err := ParseGORDecisionConstants(file)
+	if err != nil {
+		return GORDecisionConstants{}, fmt.Errorf("%s: %v", filename, err)
+	}
+	return constants, nil
+}
+
+// WriteGORDecisionConstants()
+// Input: a writer and the decision constants
+// Output: an error if writing fails
+// Writes the layout read by ParseGORDecisionConstants.
+func WriteGORDecisionConstants(w io.Writer, constants GORDecisionConstants) error {
+	writer := csv.NewWriter(w)
+	writer.Write(gorConstantsHeader)
+	for k, value := range constants.values() {
+		writer.Write([]string{string(gorStructures[k]), strconv.FormatFloat(value, 'f', -1, 64)})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// SaveGORDecisionConstants()
+// Input: the path to write to and the decision constants
+// Output: an error if the file cannot be written
+func SaveGORDecisionConstants(filename string, constants GORDecisionConstants) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if err := WriteGORDecisionConstants(file, constants); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
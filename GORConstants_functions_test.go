@@ -0,0 +1,156 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestApplyGORDecisionConstants(t *testing.T) {
+	predictions := []GORPredictionResult{
+		{Position: 1, Residue: "A", ScoreAlpha: 10, ScoreBeta: 5, ScoreTurn: 0, ScoreCoil: 8, PredictedStructure: "H"},
+		{Position: 2, Residue: "V", ScoreAlpha: 2, ScoreBeta: 9, ScoreTurn: 1, ScoreCoil: 3, PredictedStructure: "E"},
+	}
+	ApplyGORDecisionConstants(predictions, GORDecisionConstants{Alpha: -5, Beta: -5, Turn: 0, Coil: 0})
+
+	if got := OutputGORSequence(predictions); got != "CE" {
+		t.Errorf("Expected CE after the constants, got %s", got)
+	}
+	if !floatEquals(predictions[0].ScoreAlpha, 5, 1e-9) || !floatEquals(predictions[1].ScoreBeta, 4, 1e-9) {
+		t.Errorf("Expected the constants to be added to the scores, got %+v", predictions)
+	}
+}
+
+func TestFitGORDecisionConstants(t *testing.T) {
+	// Raw scores that favour helix at nearly every residue
+	var predictions []GORPredictionResult
+	for i := 0; i < 100; i++ {
+		x := float64(i % 10)
+		predictions = append(predictions, GORPredictionResult{
+			ScoreAlpha: 50 + x,
+			ScoreBeta:  float64(i%7) * 5,
+			ScoreTurn:  float64(i%3) * 4,
+			ScoreCoil:  float64((i*13)%17) * 3,
+		})
+	}
+
+	tests := []struct {
+		name        string
+		composition [4]float64 // Fractions of H, E, T and C
+		counts      map[string]int
+	}{
+		{"balanced", [4]float64{0.3, 0.2, 0.1, 0.4}, map[string]int{"H": 30, "E": 20, "T": 10, "C": 40}},
+		{"no turns", [4]float64{0.5, 0.2, 0, 0.3}, map[string]int{"H": 50, "E": 20, "T": 0, "C": 30}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			constants, err := FitGORDecisionConstants(predictions, test.composition)
+			if err != nil {
+				t.Fatalf("FitGORDecisionConstants returned error: %v", err)
+			}
+			if constants.Coil != 0 {
+				t.Errorf("Expected the coil constant to be 0, got %v", constants.Coil)
+			}
+
+			fitted := append([]GORPredictionResult(nil), predictions...)
+			ApplyGORDecisionConstants(fitted, constants)
+			counts := make(map[string]int)
+			for _, p := range fitted {
+				counts[p.PredictedStructure]++
+			}
+			for _, s := range []string{"H", "E", "T", "C"} {
+				if d := counts[s] - test.counts[s]; d < -2 || d > 2 {
+					t.Errorf("Expected about %d residues predicted %s, got %d (constants %+v)", test.counts[s], s, counts[s], constants)
+				}
+			}
+		})
+	}
+
+	if _, err := FitGORDecisionConstants(nil, [4]float64{0, 0, 0, 1}); err == nil {
+		t.Errorf("Expected an error without predictions")
+	}
+}
+
+func TestLabelComposition(t *testing.T) {
+	composition, err := LabelComposition([]LabeledProtein{
+		{Name: "p1", Sequence: "AAAA", Labels: "HHEC"},
+		{Name: "p2", Sequence: "AAAA", Labels: "TCCC"},
+	})
+	if err != nil {
+		t.Fatalf("LabelComposition returned error: %v", err)
+	}
+	expected := [4]float64{0.25, 0.125, 0.125, 0.5}
+	if composition != expected {
+		t.Errorf("Expected %v, got %v", expected, composition)
+	}
+
+	if _, err := LabelComposition([]LabeledProtein{{Name: "p1", Sequence: "AA", Labels: "HG"}}); err == nil || !strings.Contains(err.Error(), `label 'G' at position 2`) {
+		t.Errorf("Expected an error for the unreduced label, got %v", err)
+	}
+}
+
+func TestParseGORDecisionConstants(t *testing.T) {
+	constants := GORDecisionConstants{Alpha: -25.5, Beta: -48, Turn: -119.25, Coil: 0}
+	var b bytes.Buffer
+	if err := WriteGORDecisionConstants(&b, constants); err != nil {
+		t.Fatalf("WriteGORDecisionConstants returned error: %v", err)
+	}
+	parsed, err := ParseGORDecisionConstants(strings.NewReader(b.String()))
+	if err != nil {
+		t.Fatalf("ParseGORDecisionConstants returned error: %v", err)
+	}
+	if parsed != constants {
+		t.Errorf("Round trip changed the constants: %+v", parsed)
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		message string
+	}{
+		{"bad header", "Class,Value\nH,1\n", "header must be Structure,Constant"},
+		{"unknown", "Structure,Constant\nG,1\n", `unknown structure "G"`},
+		{"duplicate", "Structure,Constant\nH,1\nH,2\n", "duplicate row for H"},
+		{"invalid", "Structure,Constant\nH,x\n", `row H: invalid value "x"`},
+		{"extra value", "Structure,Constant\nH,1,2\n", "row H has 2 values, expected 1"},
+		{"missing", "Structure,Constant\nH,1\nC,0\n", "missing rows for E, T"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseGORDecisionConstants(strings.NewReader(test.content))
+			if err == nil || !strings.Contains(err.Error(), test.message) {
+				t.Errorf("Expected error containing %q, got %v", test.message, err)
+			}
+		})
+	}
+}
+
+func TestGORTrainFitConstants(t *testing.T) {
+	dir := t.TempDir()
+	var out bytes.Buffer
+	if err := RunCLI([]string{"gor-train", "-out", dir, "-fit-constants"}, &out); err != nil {
+		t.Fatalf("gor-train returned error: %v", err)
+	}
+	if !strings.Contains(out.String(), "Wrote decision constants") {
+		t.Errorf("Expected the fitted constants to be reported, got:\n%s", out.String())
+	}
+	constants, err := ReadGORDecisionConstants(filepath.Join(dir, gorConstantsFile))
+	if err != nil {
+		t.Fatalf("ReadGORDecisionConstants returned error: %v", err)
+	}
+	if constants.Coil != 0 || constants == (GORDecisionConstants{}) {
+		t.Errorf("Expected non-zero constants relative to coil, got %+v", constants)
+	}
+
+	out.Reset()
+	if err := RunCLI([]string{"predict", "-gor-dir", dir, "-gor-constants", filepath.Join(dir, gorConstantsFile), "MKTAYIAKQRQISFVKSHFSRQLEERLGLIEVQ"}, &out); err != nil {
+		t.Fatalf("predict -gor-constants returned error: %v", err)
+	}
+	if !strings.Contains(out.String(), "Predicted GOR secondary structure:") {
+		t.Errorf("Expected a GOR prediction, got:\n%s", out.String())
+	}
+}
@@ -0,0 +1,192 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GOR edge modes: how window positions beyond the ends of the sequence are scored
+const (
+	GOREdgeSkip      = "skip"      // Positions outside the sequence add nothing (the original behaviour)
+	GOREdgeNormalize = "normalize" // Scores are scaled by window size / positions inside the sequence
+	GOREdgeTerminus  = "terminus"  // Positions outside the sequence add the values of the terminus row
+)
+
+// GOREdgeModes lists the edge modes accepted by ParseGOREdgeMode
+var GOREdgeModes = []string{GOREdgeSkip, GOREdgeNormalize, GOREdgeTerminus}
+
+// gorTerminusRow is the row of the terminus pseudo-residue that pads the sequence in the terminus edge mode
+const gorTerminusRow = "-"
+
+// ParseGOREdgeMode()
+// Input: the name of an edge mode ("" for the default)
+// Output: the edge mode, or an error if the name is not one of GOREdgeModes
+func ParseGOREdgeMode(name string) (string, error) {
+	if name == "" {
+		return GOREdgeSkip, nil
+	}
+	for _, mode := range GOREdgeModes {
+		if name == mode {
+			return mode, nil
+		}
+	}
+	return "", fmt.Errorf("unknown GOR edge mode %q: expected %s", name, strings.Join(GOREdgeModes, ", "))
+}
+
+/*
+	GORPredictEdge predicts secondary structure like GORPredict, scoring the window positions beyond the ends of the
+	sequence according to an edge mode, so that residues near the termini are not pushed toward the class whose
+	information values are highest on average.
+
+Input: the protein sequence, the edge mode (see GOREdgeModes) and the alpha-helix, beta-strand, turn and coil tables,
+which must pass ValidateGORTables. The terminus mode needs a terminus row ("-") in every table, as written by gor-train.
+Output: the per-residue predictions, or an error if the edge mode is unknown or a terminus row is missing.
+*/
+func GORPredictEdge(sequence, edge string, alphaParams, betaParams, turnParams, coilParams InfoValTable) ([]GORPredictionResult, error) {
+	if err := checkGOREdgeMode(edge, gorTerminusRows([]InfoValTable{alphaParams, betaParams, turnParams, coilParams})); err != nil {
+		return nil, err
+	}
+	windowSize := len(alphaParams["X"])
+	return gorPredictWindows(sequence, edge, func(i int) []GORContribution {
+		return gorContributions(sequence, len(sequence), windowSize, i, edge, alphaParams, betaParams, turnParams, coilParams)
+	}), nil
+}
+
+/*
+	GORPairPredictEdge predicts secondary structure like GORPairPredict with an edge mode (see GORPredictEdge).
+
+Input: the protein sequence, the edge mode and the four GOR III pair tables, which must pass ValidateGORPairTables;
+the terminus mode needs a row for the neighbor "-" of every central residue in every table, as written by gor-train
+-method gor3.
+Output: the per-residue predictions, or an error as for GORPredictEdge.
+*/
+func GORPairPredictEdge(sequence, edge string, alphaParams, betaParams, turnParams, coilParams InfoPairTable) ([]GORPredictionResult, error) {
+	if err := checkGOREdgeMode(edge, gorPairTerminusRows([]InfoPairTable{alphaParams, betaParams, turnParams, coilParams})); err != nil {
+		return nil, err
+	}
+	windowSize := len(alphaParams["X"]["X"])
+	return gorPredictWindows(sequence, edge, func(i int) []GORContribution {
+		return gorPairContributions(sequence, len(sequence), windowSize, i, edge, alphaParams, betaParams, turnParams, coilParams)
+	}), nil
+}
+
+// gorPredictWindows predicts every residue of the sequence from the contributions of its window, summed with the
+// edge mode by sumGORContributions
+func gorPredictWindows(sequence, edge string, contributions func(i int) []GORContribution) []GORPredictionResult {
+	predictions := make([]GORPredictionResult, len(sequence))
+	for i := range predictions {
+		scores, _ := sumGORContributions(contributions(i), edge)
+		predictions[i] = GORPredictionResult{
+			Position:           i + 1, // Positions starting from 1
+			Residue:            string(sequence[i]),
+			ScoreAlpha:         scores[0],
+			ScoreBeta:          scores[1],
+			ScoreTurn:          scores[2],
+			ScoreCoil:          scores[3],
+			PredictedStructure: gorStructure(scores[0], scores[1], scores[2], scores[3]),
+		}
+	}
+	return predictions
+}
+
+// SetGOREdge()
+// Input: an edge mode (see GOREdgeModes)
+// Output: an error if the mode is unknown, or is the terminus mode and the loaded GOR tables (the pair tables if
+// they are loaded) have no terminus rows; on success GOR predictions use the mode
+func (p *Predictor) SetGOREdge(edge string) error {
+	edge, err := ParseGOREdgeMode(edge)
+	if err != nil {
+		return err
+	}
+	hasTerminus := gorTerminusRows([]InfoValTable{p.AlphaParams, p.BetaParams, p.TurnParams, p.CoilParams})
+	if p.AlphaPairParams != nil {
+		hasTerminus = gorPairTerminusRows([]InfoPairTable{p.AlphaPairParams, p.BetaPairParams, p.TurnPairParams, p.CoilPairParams})
+	}
+	if err := checkGOREdgeMode(edge, hasTerminus); err != nil {
+		return err
+	}
+	p.GOREdge = edge
+	return nil
+}
+
+// gorTerminusRows returns a function reporting whether table t of the given GOR tables has a terminus row
+func gorTerminusRows(tables []InfoValTable) func(t int) bool {
+	return func(t int) bool {
+		_, ok := tables[t][gorTerminusRow]
+		return ok
+	}
+}
+
+// gorPairTerminusRows returns a function reporting whether table t of the given pair tables has a terminus row for
+// every central residue
+func gorPairTerminusRows(tables []InfoPairTable) func(t int) bool {
+	return func(t int) bool {
+		for _, central := range gorResidueOrder {
+			if _, ok := tables[t][central][gorTerminusRow]; !ok {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// checkGOREdgeMode returns an error if the edge mode is unknown, or is the terminus mode and hasTerminus reports a
+// table t (0 to 3 for alpha, beta, turn and coil) without terminus rows
+func checkGOREdgeMode(edge string, hasTerminus func(t int) bool) error {
+	if _, err := ParseGOREdgeMode(edge); err != nil {
+		return err
+	}
+	if edge != GOREdgeTerminus {
+		return nil
+	}
+	for t, name := range []string{"alpha", "beta", "turn", "coil"} {
+		if !hasTerminus(t) {
+			return fmt.Errorf("the terminus edge mode needs terminus (%s) rows, but the %s table has none; tables written by gor-train have them", gorTerminusRow, name)
+		}
+	}
+	return nil
+}
+
+// newGORContribution returns the contribution of the window offset pos from residue i, with the values in the order
+// of gorStructures; positions beyond either terminus have position 0 and are named "-"
+func newGORContribution(sequence string, seqLen, i, pos int, values [4]float64) GORContribution {
+	c := GORContribution{Offset: pos, Residue: gorTerminusRow, Alpha: values[0], Beta: values[1], Turn: values[2], Coil: values[3]}
+	if windowIndex := i + pos; windowIndex >= 0 && windowIndex < seqLen {
+		c.Position = windowIndex + 1
+		c.Residue = string(sequence[windowIndex])
+	}
+	return c
+}
+
+// gorContributionValues returns the four values of a contribution in the order of gorStructures
+func gorContributionValues(c GORContribution) [4]float64 {
+	return [4]float64{c.Alpha, c.Beta, c.Turn, c.Coil}
+}
+
+// sumGORContributions returns the four scores of a window, in the order of gorStructures, and the factor they were
+// scaled by: in the normalize mode a window reaching beyond the sequence is scaled by its size over the positions
+// inside the sequence, otherwise the factor is 1
+func sumGORContributions(contributions []GORContribution, edge string) ([4]float64, float64) {
+	var scores [4]float64
+	inside := 0 // Window positions inside the sequence
+	for _, c := range contributions {
+		if c.Position > 0 {
+			inside++
+		}
+		for k, value := range gorContributionValues(c) {
+			scores[k] += value
+		}
+	}
+	scale := 1.0
+	if edge == GOREdgeNormalize && inside < len(contributions) {
+		scale = float64(len(contributions)) / float64(inside)
+		for k := range scores {
+			scores[k] *= scale
+		}
+	}
+	return scores, scale
+}
@@ -0,0 +1,163 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// zeroGORTables returns four GOR tables of the given window width with every value 0
+func zeroGORTables(windowSize int) []InfoValTable {
+	tables := make([]InfoValTable, 4)
+	for t := range tables {
+		tables[t] = make(InfoValTable)
+		for _, aa := range gorResidueOrder {
+			tables[t][aa] = make([]float64, windowSize)
+		}
+	}
+	return tables
+}
+
+// zeroGORPairTables returns four GOR III pair tables of the given window width with every value 0
+func zeroGORPairTables(windowSize int) []InfoPairTable {
+	tables := make([]InfoPairTable, 4)
+	for t := range tables {
+		tables[t] = make(InfoPairTable)
+		for _, central := range gorResidueOrder {
+			tables[t][central] = make(map[string][]float64)
+			for _, neighbor := range gorResidueOrder {
+				tables[t][central][neighbor] = make([]float64, windowSize)
+			}
+		}
+	}
+	return tables
+}
+
+func TestParseGOREdgeMode(t *testing.T) {
+	for _, name := range []string{"", "skip", "normalize", "terminus"} {
+		if _, err := ParseGOREdgeMode(name); err != nil {
+			t.Errorf("ParseGOREdgeMode(%q) returned error: %v", name, err)
+		}
+	}
+	if _, err := ParseGOREdgeMode("pad"); err == nil || !strings.Contains(err.Error(), "expected skip, normalize, terminus") {
+		t.Errorf("Expected an error for an unknown mode, got %v", err)
+	}
+}
+
+func TestGORPredictEdge(t *testing.T) {
+	// Every alanine adds 1 to the helix score at each offset; the terminus adds 5 beyond either end
+	tables := zeroGORTables(3)
+	tables[0]["A"] = []float64{1, 1, 1}
+	tables[0][gorTerminusRow] = []float64{5, 0, 5}
+	for _, table := range tables[1:] {
+		table[gorTerminusRow] = make([]float64, 3)
+	}
+
+	tests := []struct {
+		edge  string
+		alpha []float64
+	}{
+		{GOREdgeSkip, []float64{2, 3, 2}},
+		{GOREdgeNormalize, []float64{3, 3, 3}},
+		{GOREdgeTerminus, []float64{7, 3, 7}},
+	}
+	for _, test := range tests {
+		t.Run(test.edge, func(t *testing.T) {
+			predictions, err := GORPredictEdge("AAA", test.edge, tables[0], tables[1], tables[2], tables[3])
+			if err != nil {
+				t.Fatalf("GORPredictEdge returned error: %v", err)
+			}
+			for i, p := range predictions {
+				if !floatEquals(p.ScoreAlpha, test.alpha[i], 1e-9) || p.PredictedStructure != "H" {
+					t.Errorf("Residue %d: expected helix score %v, got %v (%s)", i+1, test.alpha[i], p.ScoreAlpha, p.PredictedStructure)
+				}
+			}
+		})
+	}
+
+	// The shipped tables have no terminus row
+	plain := zeroGORTables(3)
+	if _, err := GORPredictEdge("AAA", GOREdgeTerminus, plain[0], plain[1], plain[2], plain[3]); err == nil || !strings.Contains(err.Error(), "alpha table has none") {
+		t.Errorf("Expected an error for tables without terminus rows, got %v", err)
+	}
+	if _, err := GORPredictEdge("AAA", "pad", plain[0], plain[1], plain[2], plain[3]); err == nil {
+		t.Errorf("Expected an error for an unknown edge mode")
+	}
+}
+
+func TestGORPairPredictEdge(t *testing.T) {
+	// Beyond the ends, the terminus adds 4 to the coil score of a central glycine and 1 to that of any other residue
+	tables := zeroGORPairTables(3)
+	for t := range tables {
+		for _, central := range gorResidueOrder {
+			tables[t][central][gorTerminusRow] = make([]float64, 3)
+		}
+	}
+	tables[3]["G"][gorTerminusRow] = []float64{4, 0, 4}
+	tables[3]["X"][gorTerminusRow] = []float64{1, 0, 1}
+
+	predictions, err := GORPairPredictEdge("GAB", GOREdgeTerminus, tables[0], tables[1], tables[2], tables[3])
+	if err != nil {
+		t.Fatalf("GORPairPredictEdge returned error: %v", err)
+	}
+	// A has a zero terminus row; B is not in the tables and uses the X rows
+	expected := []float64{4, 0, 1}
+	for i, p := range predictions {
+		if !floatEquals(p.ScoreCoil, expected[i], 1e-9) {
+			t.Errorf("Residue %d: expected coil score %v, got %v", i+1, expected[i], p.ScoreCoil)
+		}
+	}
+}
+
+func TestEstimateGORTerminusRow(t *testing.T) {
+	// Only the coil residues at the ends have a window position beyond the sequence
+	proteins := []LabeledProtein{
+		{Name: "p1", Sequence: "AVLKAVLKAV", Labels: "CHHHHHHHHC"},
+		{Name: "p2", Sequence: "KAVLKAVLKA", Labels: "CHHHHHHHHC"},
+	}
+	tables, err := EstimateGORParameters(proteins, 3, 1)
+	if err != nil {
+		t.Fatalf("EstimateGORParameters returned error: %v", err)
+	}
+	helix, coil := tables[0][gorTerminusRow], tables[3][gorTerminusRow]
+	if helix == nil || coil == nil {
+		t.Fatalf("Expected a terminus row in every table")
+	}
+	if !(coil[0] > 0 && coil[2] > 0 && helix[0] < 0 && helix[2] < 0) || coil[1] != 0 {
+		t.Errorf("Expected the terminus to favour coil beyond either end, got helix %v, coil %v", helix, coil)
+	}
+
+	pairTables, err := EstimateGORPairParameters(proteins, 3, 1)
+	if err != nil {
+		t.Fatalf("EstimateGORPairParameters returned error: %v", err)
+	}
+	if values := pairTables[3]["A"][gorTerminusRow]; values == nil || values[2] <= 0 {
+		t.Errorf("Expected the terminus after a central A to favour coil, got %v", values)
+	}
+}
+
+func TestGOREdgeCommand(t *testing.T) {
+	sequence := "MKTAYIAKQRQISFVKSHFSRQLEERLGLIEVQ"
+	var out bytes.Buffer
+	if err := RunCLI([]string{"predict", "-gor-edge", "normalize", sequence}, &out); err != nil {
+		t.Errorf("predict -gor-edge normalize returned error: %v", err)
+	}
+	if err := RunCLI([]string{"predict", "-gor-edge", "terminus", sequence}, &out); err == nil || !strings.Contains(err.Error(), "terminus") {
+		t.Errorf("Expected an error for the built-in tables without terminus rows, got %v", err)
+	}
+
+	dir := t.TempDir()
+	if err := RunCLI([]string{"gor-train", "-out", dir, "-fit-constants", "-gor-edge", "terminus"}, &out); err != nil {
+		t.Fatalf("gor-train returned error: %v", err)
+	}
+	out.Reset()
+	if err := RunCLI([]string{"predict", "-gor-dir", dir, "-gor-edge", "terminus", sequence}, &out); err != nil {
+		t.Fatalf("predict -gor-edge terminus returned error: %v", err)
+	}
+	if !strings.Contains(out.String(), "Predicted GOR secondary structure:") {
+		t.Errorf("Expected a GOR prediction, got:\n%s", out.String())
+	}
+}
@@ -0,0 +1,152 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// GOR methods named in a GORExplanation
+const (
+	GORMethodI   = "gor1" // Single-residue information tables
+	GORMethodIII = "gor3" // Pair information tables
+)
+
+/*
+	ExplainGOR breaks the GOR scores of one residue down into the contribution of every window offset, as summed by
+	SlideWindow (or SlidePairWindow with pair tables), so it can be seen which neighbors drove the prediction.
+
+Input: a FASTARecord and the position (from 1) of the residue to explain. The predictor's tables, edge mode and
+decision constants are used, as in Predict.
+Output: the explanation, whose final scores and predicted structure match the GOR scores of Predict, or an error if
+the sequence is invalid, the position is out of range, or the edge mode needs terminus rows the tables lack.
+*/
+func (p *Predictor) ExplainGOR(record FASTARecord, position int) (GORExplanation, error) {
+	sequence := strings.ToUpper(record.Sequence)
+	if sequence == "" {
+		return GORExplanation{}, fmt.Errorf("no sequence provided")
+	}
+	if !isValidSequence(sequence) {
+		return GORExplanation{}, fmt.Errorf("invalid sequence: sequence must only contain valid amino acid codes")
+	}
+	if position < 1 || position > len(sequence) {
+		return GORExplanation{}, fmt.Errorf("position %d is outside the sequence (1 to %d)", position, len(sequence))
+	}
+	edge, err := ParseGOREdgeMode(p.GOREdge)
+	if err != nil {
+		return GORExplanation{}, err
+	}
+
+	explanation := GORExplanation{
+		ID:        record.ID,
+		Position:  position,
+		Residue:   string(sequence[position-1]),
+		Edge:      edge,
+		Constants: p.GORConstants,
+	}
+	if p.AlphaPairParams != nil {
+		tables := []InfoPairTable{p.AlphaPairParams, p.BetaPairParams, p.TurnPairParams, p.CoilPairParams}
+		if err := checkGOREdgeMode(edge, gorPairTerminusRows(tables)); err != nil {
+			return GORExplanation{}, err
+		}
+		explanation.Method = GORMethodIII
+		explanation.Contributions = gorPairContributions(sequence, len(sequence), len(tables[0]["X"]["X"]), position-1, edge, tables[0], tables[1], tables[2], tables[3])
+	} else {
+		tables := []InfoValTable{p.AlphaParams, p.BetaParams, p.TurnParams, p.CoilParams}
+		if err := checkGOREdgeMode(edge, gorTerminusRows(tables)); err != nil {
+			return GORExplanation{}, err
+		}
+		explanation.Method = GORMethodI
+		explanation.Contributions = gorContributions(sequence, len(sequence), len(tables[0]["X"]), position-1, edge, tables[0], tables[1], tables[2], tables[3])
+	}
+
+	// Sum the contributions as GORPredictEdge does, then add the decision constants
+	scores, scale := sumGORContributions(explanation.Contributions, edge)
+	explanation.Scale = scale
+	constants := p.GORConstants.values()
+	for k := range scores {
+		scores[k] += constants[k]
+	}
+	explanation.ScoreAlpha, explanation.ScoreBeta, explanation.ScoreTurn, explanation.ScoreCoil = scores[0], scores[1], scores[2], scores[3]
+	explanation.PredictedStructure = gorStructure(scores[0], scores[1], scores[2], scores[3])
+
+	// The runner-up is the highest of the other scores, ties going to the earlier structure as in gorStructure
+	best := strings.Index(string(gorStructures), explanation.PredictedStructure)
+	second := -1
+	for k := range scores {
+		if k != best && (second < 0 || scores[k] > scores[second]) {
+			second = k
+		}
+	}
+	explanation.RunnerUp = string(gorStructures[second])
+	explanation.Margin = scores[best] - scores[second]
+	return explanation, nil
+}
+
+// WriteGORExplanations()
+// Input: a writer, the output format (text or json) and the explanations
+// Output: an error if the format is unknown or writing fails
+// The text format prints a table per explanation like OutputDetailedTable, with one row per window offset; json
+// writes an indented array with one object per explanation.
+func WriteGORExplanations(w io.Writer, format string, explanations []GORExplanation) error {
+	switch format {
+	case FormatText:
+		var b strings.Builder
+		for _, e := range explanations {
+			writeGORExplanationTable(&b, e)
+		}
+		_, err := io.WriteString(w, b.String())
+		return err
+	case FormatJSON:
+		if explanations == nil {
+			explanations = []GORExplanation{} // Encode as [] rather than null
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(explanations)
+	default:
+		return fmt.Errorf("unknown output format %q (expected %s or %s)", format, FormatText, FormatJSON)
+	}
+}
+
+// writeGORExplanationTable writes the contribution of each window offset to the Ia, Ib, It and Ic scores, followed by
+// the scale, the decision constants and the final scores, with the highest marked by an asterisk
+func writeGORExplanationTable(b *strings.Builder, e GORExplanation) {
+	if e.ID != "" {
+		fmt.Fprintf(b, ">%s\n", e.ID)
+	}
+	fmt.Fprintf(b, "Residue %d (%s), method %s, edge mode %s\n", e.Position, e.Residue, e.Method, e.Edge)
+	fmt.Fprintf(b, " Offset  Pos AA       Ia       Ib       It       Ic\n")
+	fmt.Fprintln(b, "        -------------------------------------------")
+	for _, c := range e.Contributions {
+		pos := "-"
+		if c.Position > 0 {
+			pos = fmt.Sprint(c.Position)
+		}
+		fmt.Fprintf(b, "%7d %4s %-2s %8.1f %8.1f %8.1f %8.1f\n", c.Offset, pos, c.Residue, c.Alpha, c.Beta, c.Turn, c.Coil)
+	}
+	fmt.Fprintln(b, "        -------------------------------------------")
+	if e.Scale != 1 {
+		fmt.Fprintf(b, "%-15s x%.3f\n", "Scale", e.Scale)
+	}
+	if e.Constants != (GORDecisionConstants{}) {
+		fmt.Fprintf(b, "%-15s %8.1f %8.1f %8.1f %8.1f\n", "Constants", e.Constants.Alpha, e.Constants.Beta, e.Constants.Turn, e.Constants.Coil)
+	}
+
+	scores := []float64{e.ScoreAlpha, e.ScoreBeta, e.ScoreTurn, e.ScoreCoil}
+	best := strings.Index(string(gorStructures), e.PredictedStructure)
+	fmt.Fprintf(b, "%-15s", "Score")
+	for k, score := range scores {
+		formattedScore := fmt.Sprintf(" %8.1f", score)
+		if k == best {
+			formattedScore = fmt.Sprintf(" %7.1f*", score) // Mark the highest score
+		}
+		b.WriteString(formattedScore)
+	}
+	fmt.Fprintf(b, "\nPredicted %s, runner-up %s, margin %.1f centinats\n", e.PredictedStructure, e.RunnerUp, e.Margin)
+}
@@ -0,0 +1,186 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestExplainGORMatchesPredict(t *testing.T) {
+	proteins, err := ReadLabeledDataset("AccuracyTestDataset_50.csv")
+	if err != nil {
+		t.Fatalf("ReadLabeledDataset returned error: %v", err)
+	}
+	tables, err := EstimateGORParameters(proteins, 17, 1)
+	if err != nil {
+		t.Fatalf("EstimateGORParameters returned error: %v", err)
+	}
+	pairTables, err := EstimateGORPairParameters(proteins, 17, 1)
+	if err != nil {
+		t.Fatalf("EstimateGORPairParameters returned error: %v", err)
+	}
+
+	record := FASTARecord{ID: "query", Sequence: "mktayiakqrqisfvkshfsrqleerlglievq"}
+	constants := GORDecisionConstants{Alpha: -12, Beta: 7.5, Turn: -40}
+	for _, method := range []string{GORMethodI, GORMethodIII} {
+		for _, edge := range GOREdgeModes {
+			t.Run(method+"/"+edge, func(t *testing.T) {
+				p := &Predictor{AlphaParams: tables[0], BetaParams: tables[1], TurnParams: tables[2], CoilParams: tables[3],
+					HMM: NewDefaultHMM(), GORConstants: constants, GOREdge: edge}
+				if method == GORMethodIII {
+					p.AlphaPairParams, p.BetaPairParams, p.TurnPairParams, p.CoilPairParams = pairTables[0], pairTables[1], pairTables[2], pairTables[3]
+				}
+				result := p.Predict(record)
+				if result.Error != "" {
+					t.Fatalf("Predict returned error: %s", result.Error)
+				}
+
+				for _, expected := range result.GORScores {
+					e, err := p.ExplainGOR(record, expected.Position)
+					if err != nil {
+						t.Fatalf("ExplainGOR returned error: %v", err)
+					}
+					if e.Method != method || e.Edge != edge || len(e.Contributions) != 17 || e.Residue != expected.Residue {
+						t.Fatalf("Residue %d: unexpected explanation header %+v", expected.Position, e)
+					}
+					if !floatEquals(e.ScoreAlpha, expected.ScoreAlpha, 1e-9) || !floatEquals(e.ScoreBeta, expected.ScoreBeta, 1e-9) ||
+						!floatEquals(e.ScoreTurn, expected.ScoreTurn, 1e-9) || !floatEquals(e.ScoreCoil, expected.ScoreCoil, 1e-9) ||
+						e.PredictedStructure != expected.PredictedStructure {
+						t.Errorf("Residue %d: explained scores %v %v %v %v (%s) differ from predicted %v %v %v %v (%s)", expected.Position,
+							e.ScoreAlpha, e.ScoreBeta, e.ScoreTurn, e.ScoreCoil, e.PredictedStructure,
+							expected.ScoreAlpha, expected.ScoreBeta, expected.ScoreTurn, expected.ScoreCoil, expected.PredictedStructure)
+					}
+					if e.Margin < 0 || e.RunnerUp == e.PredictedStructure {
+						t.Errorf("Residue %d: invalid runner-up %s with margin %v", expected.Position, e.RunnerUp, e.Margin)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestExplainGOR(t *testing.T) {
+	// Alanine at the offset before the residue adds 3 to helix and coil; glycine at offset 0 adds 1 to strand
+	tables := zeroGORTables(3)
+	tables[0]["A"][0], tables[3]["A"][0] = 3, 3
+	tables[1]["G"][1] = 1
+	p := &Predictor{AlphaParams: tables[0], BetaParams: tables[1], TurnParams: tables[2], CoilParams: tables[3],
+		GORConstants: GORDecisionConstants{Coil: -0.5}}
+
+	e, err := p.ExplainGOR(FASTARecord{ID: "p1", Sequence: "AG"}, 2)
+	if err != nil {
+		t.Fatalf("ExplainGOR returned error: %v", err)
+	}
+	expected := []GORContribution{
+		{Offset: -1, Position: 1, Residue: "A", Alpha: 3, Coil: 3},
+		{Offset: 0, Position: 2, Residue: "G", Beta: 1},
+		{Offset: 1, Position: 0, Residue: "-"},
+	}
+	if len(e.Contributions) != len(expected) {
+		t.Fatalf("Expected %d contributions, got %d", len(expected), len(e.Contributions))
+	}
+	for i := range expected {
+		if e.Contributions[i] != expected[i] {
+			t.Errorf("Contribution %d: expected %+v, got %+v", i, expected[i], e.Contributions[i])
+		}
+	}
+	if e.ID != "p1" || e.Scale != 1 || e.PredictedStructure != "H" || e.RunnerUp != "C" || e.Margin != 0.5 {
+		t.Errorf("Expected H over C by 0.5, got %s over %s by %v (scale %v)", e.PredictedStructure, e.RunnerUp, e.Margin, e.Scale)
+	}
+
+	// In the normalize mode the two positions inside the window are scaled up to three
+	p.GOREdge = GOREdgeNormalize
+	if e, err = p.ExplainGOR(FASTARecord{Sequence: "AG"}, 2); err != nil {
+		t.Fatalf("ExplainGOR returned error: %v", err)
+	}
+	if e.Scale != 1.5 || e.ScoreAlpha != 4.5 || e.ScoreCoil != 4 {
+		t.Errorf("Expected scale 1.5 with helix 4.5 and coil 4, got %v, %v and %v", e.Scale, e.ScoreAlpha, e.ScoreCoil)
+	}
+
+	errorTests := []struct {
+		name     string
+		sequence string
+		position int
+		edge     string
+		expected string
+	}{
+		{"position too large", "AG", 3, GOREdgeSkip, "position 3 is outside the sequence (1 to 2)"},
+		{"position zero", "AG", 0, GOREdgeSkip, "outside the sequence"},
+		{"invalid sequence", "AZ", 1, GOREdgeSkip, "invalid sequence"},
+		{"empty sequence", "", 1, GOREdgeSkip, "no sequence"},
+		{"no terminus rows", "AG", 1, GOREdgeTerminus, "terminus edge mode"},
+	}
+	for _, test := range errorTests {
+		t.Run(test.name, func(t *testing.T) {
+			p.GOREdge = test.edge
+			if _, err := p.ExplainGOR(FASTARecord{Sequence: test.sequence}, test.position); err == nil || !strings.Contains(err.Error(), test.expected) {
+				t.Errorf("Expected error containing %q, got %v", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestWriteGORExplanations(t *testing.T) {
+	tables := zeroGORTables(3)
+	tables[0]["A"][1] = 2
+	p := &Predictor{AlphaParams: tables[0], BetaParams: tables[1], TurnParams: tables[2], CoilParams: tables[3],
+		GORConstants: GORDecisionConstants{Turn: 1}}
+	e, err := p.ExplainGOR(FASTARecord{ID: "p1", Sequence: "AA"}, 1)
+	if err != nil {
+		t.Fatalf("ExplainGOR returned error: %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := WriteGORExplanations(&out, FormatText, []GORExplanation{e}); err != nil {
+		t.Fatalf("WriteGORExplanations returned error: %v", err)
+	}
+	for _, want := range []string{">p1\n", "Residue 1 (A), method gor1, edge mode skip", "Constants", "     2.0*", "Predicted H, runner-up T, margin 1.0 centinats"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Expected text output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+
+	out.Reset()
+	if err := WriteGORExplanations(&out, FormatJSON, []GORExplanation{e}); err != nil {
+		t.Fatalf("WriteGORExplanations returned error: %v", err)
+	}
+	var decoded []GORExplanation
+	if err := json.Unmarshal(out.Bytes(), &decoded); err != nil {
+		t.Fatalf("Failed to decode JSON output: %v", err)
+	}
+	if len(decoded) != 1 || decoded[0].Margin != 1 || len(decoded[0].Contributions) != 3 || decoded[0].Contributions[2].Residue != "A" {
+		t.Errorf("Unexpected decoded explanation: %+v", decoded)
+	}
+
+	if err := WriteGORExplanations(&out, FormatTSV, nil); err == nil {
+		t.Errorf("Expected an error for the tsv format")
+	}
+}
+
+func TestGORExplainCommand(t *testing.T) {
+	var out bytes.Buffer
+	if err := RunCLI([]string{"gor-explain", "-position", "5", "MKTAYIAKQRQISFVKSHFSRQLEERLGLIEVQ"}, &out); err != nil {
+		t.Fatalf("gor-explain returned error: %v", err)
+	}
+	if !strings.Contains(out.String(), "Residue 5 (Y), method gor1, edge mode skip") {
+		t.Errorf("Unexpected gor-explain output:\n%s", out.String())
+	}
+
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"gor-explain", "MKTAY"}, "-position"},
+		{[]string{"gor-explain", "-position", "6", "MKTAY"}, "position 6 is outside the sequence"},
+		{[]string{"gor-explain", "-position", "1", "-format", "tsv", "MKTAY"}, "expected text or json"},
+	}
+	for _, test := range tests {
+		if err := RunCLI(test.args, &out); err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("%v: expected error containing %q, got %v", test.args, test.expected, err)
+		}
+	}
+}
@@ -0,0 +1,493 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// gorStructures lists the GOR structure classes in the order of the four information tables
+var gorStructures = []byte{'H', 'E', 'T', 'C'}
+
+// gorPairFiles names the GOR III pair information table of each class in gorStructures
+var gorPairFiles = []string{"InfoPair_aHelix.csv", "InfoPair_bStrand.csv", "InfoPair_bTurn.csv", "InfoPair_Coil.csv"}
+
+/*
+	ReadGORPairParameters reads a GOR III pair information table from a CSV file.
+
+Input: Filename (string) of the .csv file, with a header line Central,Neighbor,-h,...,h followed by rows of the form
+Central,Neighbor,v(-h),...,v(+h); the standard window has h = 8.
+Output: An InfoPairTable where table[central][neighbor][k] is the information that the neighbor residue at offset k-8
+carries about the structure of the central residue, given the central residue type. The offset 0 value of the
+row with neighbor == central is the single-residue information of the central residue.
+*/
+func ReadGORPairParameters(filename string) (InfoPairTable, error) {
+	// Open the CSV file
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	params, err := ParseGORPairParameters(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return params, nil
+}
+
+// ParseGORPairParameters()
+// Input: an io.Reader with the CSV contents described in ReadGORPairParameters
+// Output: the InfoPairTable, or an error if the header offsets are not -h..h, a row does not have one value per
+// offset, a pair appears twice, or a row for a pair of standard amino acids or X is missing
+func ParseGORPairParameters(r io.Reader) (InfoPairTable, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1 // Row lengths are checked below with a clearer message
+
+	// Read the header line to find the window
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %v", err)
+	}
+	if len(header) < 2 || strings.TrimPrefix(header[0], "\ufeff") != "Central" || header[1] != "Neighbor" {
+		return nil, fmt.Errorf("header must start with Central,Neighbor, got %q", strings.Join(header, ","))
+	}
+	windowSize, err := parseGOROffsets(header[2:])
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(InfoPairTable)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break // End of file reached
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read record: %v", err)
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("row %s needs a central residue, a neighbor residue and information values", strings.Join(record, ","))
+		}
+
+		// The first two fields are the central and neighbor amino acid codes
+		central, neighbor := record[0], record[1]
+		if _, seen := params[central][neighbor]; seen {
+			return nil, fmt.Errorf("duplicate row for %s,%s", central, neighbor)
+		}
+		if len(record)-2 != windowSize {
+			return nil, fmt.Errorf("row %s,%s has %d values but the header has %d offsets", central, neighbor, len(record)-2, windowSize)
+		}
+		values := make([]float64, 0, windowSize)
+		for k, valStr := range record[2:] {
+			val, err := strconv.ParseFloat(valStr, 64)
+			if err != nil {
+				return nil, fmt.Errorf("row %s,%s: invalid value %q at offset %s", central, neighbor, valStr, header[k+2])
+			}
+			values = append(values, val)
+		}
+
+		if params[central] == nil {
+			params[central] = make(map[string][]float64)
+		}
+		params[central][neighbor] = values
+	}
+
+	if missing := missingGORPairRows(params); len(missing) > 0 {
+		if len(missing) > 5 {
+			missing = append(missing[:5], fmt.Sprintf("and %d more", len(missing)-5))
+		}
+		return nil, fmt.Errorf("missing rows for %s (every pair of standard amino acids and X is required)", strings.Join(missing, "; "))
+	}
+	return params, nil
+}
+
+// missingGORPairRows returns the central,neighbor pairs of gorResidueOrder that have no row in the table
+func missingGORPairRows(params InfoPairTable) []string {
+	var missing []string
+	for _, central := range gorResidueOrder {
+		for _, neighbor := range gorResidueOrder {
+			if _, ok := params[central][neighbor]; !ok {
+				missing = append(missing, central+","+neighbor)
+			}
+		}
+	}
+	return missing
+}
+
+// ValidateGORPairTables()
+// Input: the alpha-helix, beta-strand, turn and coil pair tables
+// Output: an error if a table misses a required row, its rows differ in length, or the tables disagree on the window width
+func ValidateGORPairTables(alphaParams, betaParams, turnParams, coilParams InfoPairTable) error {
+	names := []string{"alpha", "beta", "turn", "coil"}
+	widths := make([]int, 4)
+	for t, table := range []InfoPairTable{alphaParams, betaParams, turnParams, coilParams} {
+		if missing := missingGORPairRows(table); len(missing) > 0 {
+			return fmt.Errorf("%s pair table is missing rows, starting with %s", names[t], missing[0])
+		}
+		widths[t] = len(table["X"]["X"])
+		for central, rows := range table {
+			for neighbor, values := range rows {
+				if len(values) != widths[t] {
+					return fmt.Errorf("%s pair table row %s,%s has %d values but row X,X has %d", names[t], central, neighbor, len(values), widths[t])
+				}
+			}
+		}
+		if widths[t]%2 == 0 {
+			return fmt.Errorf("%s pair table has an even window width %d", names[t], widths[t])
+		}
+	}
+	for t := 1; t < len(widths); t++ {
+		if widths[t] != widths[0] {
+			return fmt.Errorf("pair tables disagree on the window width: alpha %d, beta %d, turn %d, coil %d", widths[0], widths[1], widths[2], widths[3])
+		}
+	}
+	return nil
+}
+
+// LoadGORPairParameters()
+// Input: a directory holding the four GOR III tables (InfoPair_aHelix.csv, InfoPair_bStrand.csv, InfoPair_bTurn.csv
+// and InfoPair_Coil.csv)
+// Output: an error if a table cannot be read; on success the predictor uses GOR III instead of GOR I
+func (p *Predictor) LoadGORPairParameters(dir string) error {
+	tables := make([]InfoPairTable, len(gorPairFiles))
+	for i, name := range gorPairFiles {
+		table, err := ReadGORPairParameters(filepath.Join(dir, name))
+		if err != nil {
+			return fmt.Errorf("error reading GOR III parameters: %v", err)
+		}
+		tables[i] = table
+	}
+	if err := ValidateGORPairTables(tables[0], tables[1], tables[2], tables[3]); err != nil {
+		return fmt.Errorf("invalid GOR III parameters in %s: %v", dir, err)
+	}
+	p.AlphaPairParams, p.BetaPairParams, p.TurnPairParams, p.CoilPairParams = tables[0], tables[1], tables[2], tables[3]
+	return nil
+}
+
+/*
+	GORPairPredict predicts the secondary structure of a protein sequence with GOR III, which adds to the information of
+	each residue the pair information of the central residue type combined with each neighbor in the window.
+
+Input: Protein sequence of type string, alpha-helix, beta sheet, turn, and coil pair information tables, which must
+pass ValidateGORPairTables (LoadGORPairParameters checks them when it loads them).
+Output: Returns a slice of predicted structures, in the same form as GORPredict.
+*/
+func GORPairPredict(sequence string, alphaParams, betaParams, turnParams, coilParams InfoPairTable) ([]GORPredictionResult, error) {
+	windowSize := len(alphaParams["X"]["X"]) // Given by the tables, 17 for positions -8 to +8
+
+	seqLen := len(sequence)
+	predictions := make([]GORPredictionResult, seqLen)
+	for i := 0; i < seqLen; i++ {
+		scoreAlpha, scoreBeta, scoreTurn, scoreCoil := SlidePairWindow(sequence, seqLen, windowSize, i, alphaParams, betaParams, turnParams, coilParams)
+		predictions[i] = GORPredictionResult{
+			Position:           i + 1, // Positions starting from 1
+			Residue:            string(sequence[i]),
+			ScoreAlpha:         scoreAlpha,
+			ScoreBeta:          scoreBeta,
+			ScoreTurn:          scoreTurn,
+			ScoreCoil:          scoreCoil,
+			PredictedStructure: gorStructure(scoreAlpha, scoreBeta, scoreTurn, scoreCoil),
+		}
+	}
+	return predictions, nil
+}
+
+/*
+	SlidePairWindow(): Returns the four GOR III information scores of the residue at index i.
+
+Input: the protein sequence, its length, the window size, the index of the central residue and the four pair tables,
+which must pass ValidateGORPairTables.
+Output: Four float64 values: the sum over the window of table[central][neighbor][offset] for each structure.
+Residues missing from a table use its X rows; if those are missing too the position adds nothing.
+*/
+func SlidePairWindow(sequence string, seqLen, windowSize, i int, alphaParams, betaParams, turnParams, coilParams InfoPairTable) (float64, float64, float64, float64) {
+	contributions := gorPairContributions(sequence, seqLen, windowSize, i, GOREdgeSkip, alphaParams, betaParams, turnParams, coilParams)
+	scores, _ := sumGORContributions(contributions, GOREdgeSkip)
+	return scores[0], scores[1], scores[2], scores[3]
+}
+
+/*
+	gorPairContributions(): Returns the values each position in the reading window of residue i adds to the four GOR
+	III information scores.
+
+Input: the protein sequence, its length, the window size, the index of the central residue, the edge mode and the
+four pair tables.
+Output: one GORContribution per window offset holding table[central][neighbor][offset] for each structure, with
+missing residues and rows handled as in SlidePairWindow. Positions beyond the ends of the sequence add nothing, or
+the values of the neighbor row "-" in the terminus edge mode.
+*/
+func gorPairContributions(sequence string, seqLen, windowSize, i int, edge string, alphaParams, betaParams, turnParams, coilParams InfoPairTable) []GORContribution {
+	halfWindow := windowSize / 2
+	central := string(sequence[i])
+	tables := []InfoPairTable{alphaParams, betaParams, turnParams, coilParams}
+
+	contributions := make([]GORContribution, 0, windowSize)
+	for pos := -halfWindow; pos <= halfWindow; pos++ {
+		windowIndex := i + pos
+		inside := windowIndex >= 0 && windowIndex < seqLen
+
+		var values [4]float64
+		for t, table := range tables {
+			rows, ok := table[central]
+			if !ok {
+				rows = table["X"]
+			}
+			var row []float64
+			switch {
+			case inside:
+				if row, ok = rows[string(sequence[windowIndex])]; !ok {
+					row = rows["X"]
+				}
+			case edge == GOREdgeTerminus:
+				row = rows[gorTerminusRow]
+			}
+			if paramIndex := pos + halfWindow; paramIndex < len(row) {
+				values[t] = row[paramIndex]
+			}
+		}
+		contributions = append(contributions, newGORContribution(sequence, seqLen, i, pos, values))
+	}
+	return contributions
+}
+
+// EstimateGORPairParameters()
+// Input: labeled proteins with H, E, T and C labels, the window size and the pseudocount added to every count
+// Output: the alpha, beta, turn and coil pair tables in centinats (hundredths of a nat), or an error if a protein
+// has mismatched lengths or there is nothing to count; a structure without training residues gets
+// gorAbsentInformation as the information of every central residue and zeros elsewhere
+// Following Gibrat, Garnier and Robson (1987), the offset 0 value of row (R, R) is the information I(S; R) of the
+// central residue R about structure S, and the value of row (R, R') at offset m is the additional information
+// I(S; R' at m | R) = ln[f(S, R, R'@m) / f(n-S, R, R'@m)] - ln[f(S, R, .@m) / f(n-S, R, .@m)], where n-S is every
+// other structure. Residues other than the 20 standard amino acids are not counted; their X rows are zero. Positions
+// beyond the ends of a sequence are counted in the rows of the terminus pseudo-residue "-" as neighbor, used by the
+// terminus edge mode (see GORPairPredictEdge).
+func EstimateGORPairParameters(proteins []LabeledProtein, windowSize int, pseudocount float64) ([]InfoPairTable, error) {
+	if pseudocount < 0 {
+		return nil, fmt.Errorf("pseudocount must not be negative, got %g", pseudocount)
+	}
+	if windowSize < 1 || windowSize%2 == 0 {
+		return nil, fmt.Errorf("window size must be a positive odd number, got %d", windowSize)
+	}
+	halfWindow := windowSize / 2
+	nAA, nS := len(AminoAcidSymbols), len(gorStructures)
+	aaIndex := make(map[byte]int, nAA)
+	for i, aa := range AminoAcidSymbols {
+		aaIndex[aa[0]] = i
+	}
+	sIndex := make(map[byte]int, nS)
+	for i, s := range gorStructures {
+		sIndex[s] = i
+	}
+
+	// single[s][a] counts central residues a in structure s; pair[s][a][b][k] those with residue b at offset k-halfWindow,
+	// and terminus[s][a][k] those with offset k-halfWindow beyond an end of the sequence
+	single := make([][]float64, nS)
+	pair := make([][][][]float64, nS)
+	terminus := make([][][]float64, nS)
+	for s := range pair {
+		single[s] = make([]float64, nAA)
+		terminus[s] = make([][]float64, nAA)
+		for a := range terminus[s] {
+			terminus[s][a] = make([]float64, windowSize)
+		}
+		pair[s] = make([][][]float64, nAA)
+		for a := range pair[s] {
+			pair[s][a] = make([][]float64, nAA)
+			for b := range pair[s][a] {
+				pair[s][a][b] = make([]float64, windowSize)
+			}
+		}
+	}
+
+	counted := 0
+	for _, protein := range proteins {
+		if len(protein.Sequence) != len(protein.Labels) {
+			return nil, fmt.Errorf("%s: sequence has %d residues but %d labels", protein.Name, len(protein.Sequence), len(protein.Labels))
+		}
+		for i := 0; i < len(protein.Sequence); i++ {
+			s, ok := sIndex[protein.Labels[i]]
+			if !ok {
+				return nil, fmt.Errorf("%s: unknown label %c at position %d (expected H, E, T or C)", protein.Name, protein.Labels[i], i+1)
+			}
+			a, ok := aaIndex[protein.Sequence[i]]
+			if !ok {
+				continue // Non-standard central residue
+			}
+			single[s][a]++
+			counted++
+			for pos := -halfWindow; pos <= halfWindow; pos++ {
+				j := i + pos
+				if j < 0 || j >= len(protein.Sequence) {
+					terminus[s][a][pos+halfWindow]++
+					continue
+				}
+				if b, ok := aaIndex[protein.Sequence[j]]; ok {
+					pair[s][a][b][pos+halfWindow]++
+				}
+			}
+		}
+	}
+	if counted == 0 {
+		return nil, fmt.Errorf("no labeled residues to estimate GOR III parameters from")
+	}
+
+	totals := make([]float64, nS)
+	for s := range single {
+		for a := range single[s] {
+			totals[s] += single[s][a]
+		}
+	}
+	allTotal := 0.0
+	for _, total := range totals {
+		allTotal += total
+	}
+
+	tables := make([]InfoPairTable, nS)
+	for s := range tables {
+		table := make(InfoPairTable)
+		notTotal := allTotal - totals[s]
+		for a, central := range AminoAcidSymbols {
+			rows := make(map[string][]float64)
+			for b, neighbor := range AminoAcidSymbols {
+				values := make([]float64, windowSize)
+				for k := 0; k < windowSize; k++ {
+					if k == halfWindow {
+						if a == b {
+							notSingle := -single[s][a]
+							for other := range single {
+								notSingle += single[other][a]
+							}
+							values[k] = informationCentinats(single[s][a], notSingle, pseudocount) -
+								informationCentinats(totals[s], notTotal, float64(nAA)*pseudocount)
+						}
+						continue
+					}
+					inS, notS, marginS, notMargin := 0.0, 0.0, 0.0, 0.0
+					for other := range pair {
+						count := pair[other][a][b][k]
+						margin := 0.0
+						for c := range pair[other][a] {
+							margin += pair[other][a][c][k]
+						}
+						if other == s {
+							inS, marginS = count, margin
+						} else {
+							notS += count
+							notMargin += margin
+						}
+					}
+					values[k] = informationCentinats(inS, notS, pseudocount) - informationCentinats(marginS, notMargin, float64(nAA)*pseudocount)
+				}
+				rows[neighbor] = values
+			}
+			rows["X"] = make([]float64, windowSize)
+
+			// The terminus pseudo-residue as the neighbor, relative to every residue a of the structure
+			values := make([]float64, windowSize)
+			for k := range values {
+				if k == halfWindow {
+					continue // The central residue is never beyond an end
+				}
+				notS, notSingle := 0.0, 0.0
+				for other := range terminus {
+					if other != s {
+						notS += terminus[other][a][k]
+						notSingle += single[other][a]
+					}
+				}
+				values[k] = informationCentinats(terminus[s][a][k], notS, pseudocount) - informationCentinats(single[s][a], notSingle, float64(nAA+1)*pseudocount)
+			}
+			rows[gorTerminusRow] = values
+
+			if totals[s] == 0 {
+				// Structure not in the training labels
+				for neighbor := range rows {
+					rows[neighbor] = make([]float64, windowSize)
+				}
+				rows[central][halfWindow] = gorAbsentInformation
+			}
+			table[central] = rows
+		}
+		xRows := make(map[string][]float64)
+		for _, neighbor := range append(append([]string{}, AminoAcidSymbols...), "X", gorTerminusRow) {
+			xRows[neighbor] = make([]float64, windowSize)
+		}
+		table["X"] = xRows
+		tables[s] = table
+	}
+	return tables, nil
+}
+
+// WriteGORPairParameters()
+// Input: a writer and a pair table with the window size given by the length of its rows
+// Output: an error if writing fails
+// Writes the Central,Neighbor,-8..+8 layout read by ReadGORPairParameters in the residue order of GOR_InfoVals,
+// with values rounded to whole centinats.
+func WriteGORPairParameters(w io.Writer, table InfoPairTable) error {
+	windowSize := 0
+	for _, rows := range table {
+		for _, values := range rows {
+			windowSize = len(values)
+			break
+		}
+		break
+	}
+	halfWindow := windowSize / 2
+
+	writer := csv.NewWriter(w)
+	header := []string{"Central", "Neighbor"}
+	for pos := -halfWindow; pos <= halfWindow; pos++ {
+		header = append(header, strconv.Itoa(pos))
+	}
+	writer.Write(header)
+
+	for _, central := range gorResidueOrder {
+		for _, neighbor := range append(gorResidueOrder, gorTerminusRow) {
+			values, ok := table[central][neighbor]
+			if !ok {
+				continue
+			}
+			row := []string{central, neighbor}
+			for _, v := range values {
+				row = append(row, strconv.FormatFloat(math.Round(v)+0, 'f', 0, 64)) // Adding 0 writes -0 as 0
+			}
+			writer.Write(row)
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// SaveGORPairParameters()
+// Input: a directory and the alpha, beta, turn and coil pair tables, in that order
+// Output: an error if the directory or a file cannot be written
+func SaveGORPairParameters(dir string, tables []InfoPairTable) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for i, name := range gorPairFiles {
+		file, err := os.Create(filepath.Join(dir, name))
+		if err != nil {
+			return err
+		}
+		if err := WriteGORPairParameters(file, tables[i]); err != nil {
+			file.Close()
+			return err
+		}
+		if err := file.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
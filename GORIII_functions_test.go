@@ -0,0 +1,173 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"bytes"
+	"math"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSlidePairWindow(t *testing.T) {
+	// Window of 3: offsets -1, 0, +1
+	alpha := InfoPairTable{
+		"A": {"A": {1, 10, 2}, "G": {3, 0, 4}},
+		"G": {"A": {5, 0, 6}, "G": {7, 20, 8}},
+		"X": {"X": {0, 0, 0}},
+	}
+	empty := InfoPairTable{}
+
+	tests := []struct {
+		sequence string
+		index    int
+		expected float64
+	}{
+		{"AG", 0, 10 + 4}, // A self, G at +1 given A
+		{"AG", 1, 5 + 20}, // A at -1 given G, G self
+		{"GAG", 1, 3 + 10 + 4},
+		{"WAW", 1, 10}, // W is not in the A rows and there is no A,X row
+		{"WW", 0, 0},   // W uses the X central rows
+	}
+	for _, test := range tests {
+		a, b, tt, c := SlidePairWindow(test.sequence, len(test.sequence), 3, test.index, alpha, empty, empty, empty)
+		if !floatEquals(a, test.expected, 1e-9) || b != 0 || tt != 0 || c != 0 {
+			t.Errorf("SlidePairWindow(%s, %d) = %v, %v, %v, %v; expected alpha %v", test.sequence, test.index, a, b, tt, c, test.expected)
+		}
+	}
+}
+
+func TestEstimateGORPairParameters(t *testing.T) {
+	proteins := []LabeledProtein{{Name: "p1", Sequence: "AAGG", Labels: "HHCC"}}
+	tables, err := EstimateGORPairParameters(proteins, 17, 1)
+	if err != nil {
+		t.Fatalf("EstimateGORPairParameters returned error: %v", err)
+	}
+	if len(tables) != 4 {
+		t.Fatalf("Expected 4 tables, got %d", len(tables))
+	}
+	helix := tables[0]
+
+	// Self information: 100 ln(3/1) - 100 ln((2+20)/(2+20))
+	if got := helix["A"]["A"][8]; !floatEquals(got, 100*math.Log(3), 1e-9) {
+		t.Errorf("Expected self information %v, got %v", 100*math.Log(3), got)
+	}
+	// A after A in a helix: 100 ln(2/1) - 100 ln((2+20)/(0+20))
+	if got := helix["A"]["A"][9]; !floatEquals(got, 100*math.Log(2)-100*math.Log(22.0/20), 1e-9) {
+		t.Errorf("Unexpected pair information %v", got)
+	}
+	// Only the self row has an offset 0 value, and X rows are zero
+	if helix["A"]["G"][8] != 0 || helix["X"]["A"][9] != 0 || helix["A"]["X"][9] != 0 {
+		t.Errorf("Expected zero values, got %v %v %v", helix["A"]["G"][8], helix["X"]["A"][9], helix["A"]["X"][9])
+	}
+	// A is never coil
+	if tables[3]["A"]["A"][8] >= 0 {
+		t.Errorf("Expected negative coil information for A, got %v", tables[3]["A"]["A"][8])
+	}
+
+	// Writing and reading back keeps the values to the nearest centinat
+	var b bytes.Buffer
+	if err := WriteGORPairParameters(&b, helix); err != nil {
+		t.Fatalf("WriteGORPairParameters returned error: %v", err)
+	}
+	if !strings.HasPrefix(b.String(), "Central,Neighbor,-8,-7,") {
+		t.Errorf("Unexpected header: %s", strings.SplitN(b.String(), "\n", 2)[0])
+	}
+	read, err := ParseGORPairParameters(&b)
+	if err != nil {
+		t.Fatalf("ParseGORPairParameters returned error: %v", err)
+	}
+	if len(read) != 21 || len(read["A"]) != 22 || read["A"]["A"][8] != 110 || read["A"][gorTerminusRow] == nil {
+		t.Errorf("Unexpected table read back: %d rows, A,A = %v", len(read), read["A"]["A"])
+	}
+}
+
+func TestEstimateGORPairParametersErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		proteins    []LabeledProtein
+		pseudocount float64
+		message     string
+	}{
+		{"length mismatch", []LabeledProtein{{Name: "p1", Sequence: "AAG", Labels: "HH"}}, 1, "p1: sequence has 3 residues but 2 labels"},
+		{"unknown label", []LabeledProtein{{Name: "p1", Sequence: "AG", Labels: "HG"}}, 1, "p1: unknown label G at position 2"},
+		{"negative pseudocount", []LabeledProtein{{Name: "p1", Sequence: "AG", Labels: "HC"}}, -1, "pseudocount must not be negative"},
+		{"no residues", nil, 1, "no labeled residues"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := EstimateGORPairParameters(test.proteins, 17, test.pseudocount)
+			if err == nil || !strings.Contains(err.Error(), test.message) {
+				t.Errorf("Expected error containing %q, got %v", test.message, err)
+			}
+		})
+	}
+}
+
+func TestPredictWithGORPairParameters(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "gor3")
+	var out bytes.Buffer
+	if err := RunCLI([]string{"gor-train", "-method", "gor3", "-data", "AccuracyTestDataset_50.csv", "-out", dir}, &out); err != nil {
+		t.Fatalf("gor-train returned error: %v", err)
+	}
+
+	predictor, err := NewPredictor("GOR_InfoVals", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gor1 := predictor.Predict(FASTARecord{Sequence: "MKTAYIAKQRQISFVKSHFSRQLEERLGLIEVQ"})
+	if err := predictor.LoadGORPairParameters(dir); err != nil {
+		t.Fatalf("LoadGORPairParameters returned error: %v", err)
+	}
+	gor3 := predictor.Predict(FASTARecord{Sequence: "MKTAYIAKQRQISFVKSHFSRQLEERLGLIEVQ"})
+	if gor3.Error != "" || len(gor3.GOR) != 33 {
+		t.Fatalf("Unexpected GOR III prediction %+v", gor3)
+	}
+	if gor3.GORScores[0].ScoreAlpha == gor1.GORScores[0].ScoreAlpha {
+		t.Errorf("Expected GOR III scores to differ from GOR I")
+	}
+
+	if err := predictor.LoadGORPairParameters(t.TempDir()); err == nil || !strings.Contains(err.Error(), "InfoPair_aHelix.csv") {
+		t.Errorf("Expected a missing table error, got %v", err)
+	}
+}
+
+func TestParseGORPairParametersWindow(t *testing.T) {
+	tables, err := EstimateGORPairParameters([]LabeledProtein{{Name: "p1", Sequence: "AAGG", Labels: "HHCC"}}, 5, 1)
+	if err != nil {
+		t.Fatalf("EstimateGORPairParameters returned error: %v", err)
+	}
+	var b bytes.Buffer
+	if err := WriteGORPairParameters(&b, tables[0]); err != nil {
+		t.Fatal(err)
+	}
+	content := b.String()
+
+	table, err := ParseGORPairParameters(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("ParseGORPairParameters returned error: %v", err)
+	}
+	if len(table["X"]["X"]) != 5 {
+		t.Errorf("Expected a window of 5, got %d", len(table["X"]["X"]))
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		message string
+	}{
+		{"bad header", strings.Replace(content, "Central", "Centre", 1), "header must start with Central,Neighbor"},
+		{"short row", strings.Replace(content, "\nA,A,", "\nA,A,1,", 1), "row A,A has 6 values but the header has 5 offsets"},
+		{"missing rows", strings.SplitN(content, "\nX,", 2)[0] + "\n", "missing rows for X,G; X,A"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseGORPairParameters(strings.NewReader(test.content))
+			if err == nil || !strings.Contains(err.Error(), test.message) {
+				t.Errorf("Expected error containing %q, got %v", test.message, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// DefaultGORTemperature is the softmax temperature (in centinats) used when none has been fitted; it reads the
+// information sums as natural log odds
+const DefaultGORTemperature = 100.0
+
+// gorProbabilities returns the softmax of the four scores of a residue divided by the temperature, in the order of
+// gorStructures
+func gorProbabilities(p GORPredictionResult, temperature float64) [4]float64 {
+	scores := gorScores(p)
+	maxScore := math.Inf(-1)
+	for _, score := range scores {
+		maxScore = math.Max(maxScore, score)
+	}
+
+	var probs [4]float64
+	sum := 0.0
+	for k, score := range scores {
+		probs[k] = math.Exp((score - maxScore) / temperature) // Shifted by the maximum to avoid overflow
+		sum += probs[k]
+	}
+	for k := range probs {
+		probs[k] /= sum
+	}
+	return probs
+}
+
+// gorReliability returns the reliability index of a residue, 0 (unreliable) to 9 (reliable), from the difference
+// between its two highest class probabilities, as in the PHD method
+func gorReliability(probs [4]float64) int {
+	sorted := probs
+	sort.Float64s(sorted[:])
+	return Min(int(10*(sorted[3]-sorted[2])), 9)
+}
+
+/*
+	SetGORProbabilities converts the GOR scores of each residue into class probabilities and a reliability index.
+
+Input: the per-residue GOR predictions (changed in place) and the softmax temperature in centinats (0 for
+DefaultGORTemperature). Scores are divided by the temperature, so a higher temperature gives flatter probabilities.
+Output: none; ProbAlpha, ProbBeta, ProbTurn, ProbCoil and Reliability are set on every prediction.
+*/
+func SetGORProbabilities(predictions []GORPredictionResult, temperature float64) {
+	if temperature <= 0 {
+		temperature = DefaultGORTemperature
+	}
+	for i := range predictions {
+		probs := gorProbabilities(predictions[i], temperature)
+		predictions[i].ProbAlpha = probs[0]
+		predictions[i].ProbBeta = probs[1]
+		predictions[i].ProbTurn = probs[2]
+		predictions[i].ProbCoil = probs[3]
+		predictions[i].Reliability = gorReliability(probs)
+	}
+}
+
+/*
+	GORLogLoss returns the mean negative log-likelihood of the true labels under the GOR class probabilities.
+
+Input: the per-residue GOR predictions, the true labels (one per prediction), a reduction scheme and the softmax
+temperature. The probability of a label is the summed probability of the GOR classes the scheme maps to it, so T
+counts toward C under the hec scheme.
+Output: the mean log loss in nats, or an error if the lengths differ or no GOR class maps to a label.
+*/
+func GORLogLoss(predictions []GORPredictionResult, labels string, scheme ReductionScheme, temperature float64) (float64, error) {
+	if len(predictions) != len(labels) {
+		return 0, fmt.Errorf("%d predictions but %d labels", len(predictions), len(labels))
+	}
+	if len(predictions) == 0 {
+		return 0, fmt.Errorf("no residues")
+	}
+	classes := scheme.Reduce(string(gorStructures)) // The class each GOR structure counts as
+
+	loss := 0.0
+	for i, p := range predictions {
+		probs := gorProbabilities(p, temperature)
+		prob := 0.0
+		found := false
+		for k := range probs {
+			if classes[k] == labels[i] {
+				prob += probs[k]
+				found = true
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("label %q at residue %d is not a class GOR predicts under reduction %s", labels[i], i+1, scheme.Name)
+		}
+		loss -= math.Log(math.Max(prob, 1e-300))
+	}
+	return loss / float64(len(predictions)), nil
+}
+
+/*
+	FitGORTemperature finds the softmax temperature that minimises the log loss of held-out labels, so that the GOR
+	probabilities are calibrated.
+
+Input: the per-residue GOR predictions on proteins the tables were not estimated from, their true labels, and the
+reduction scheme the labels were reduced with.
+Output: the fitted temperature in centinats and its log loss, or an error from GORLogLoss.
+
+The temperature is searched by golden-section search over its logarithm between 1 and 100000 centinats.
+*/
+func FitGORTemperature(predictions []GORPredictionResult, labels string, scheme ReductionScheme) (float64, float64, error) {
+	if _, err := GORLogLoss(predictions, labels, scheme, DefaultGORTemperature); err != nil {
+		return 0, 0, err
+	}
+	loss := func(logTemperature float64) float64 {
+		value, _ := GORLogLoss(predictions, labels, scheme, math.Exp(logTemperature))
+		return value
+	}
+
+	ratio := (math.Sqrt(5) - 1) / 2
+	low, high := math.Log(1), math.Log(100000)
+	x1, x2 := high-ratio*(high-low), low+ratio*(high-low)
+	f1, f2 := loss(x1), loss(x2)
+	for high-low > 1e-6 {
+		if f1 < f2 {
+			high, x2, f2 = x2, x1, f1
+			x1 = high - ratio*(high-low)
+			f1 = loss(x1)
+		} else {
+			low, x1, f1 = x1, x2, f2
+			x2 = low + ratio*(high-low)
+			f2 = loss(x2)
+		}
+	}
+	temperature := math.Exp((low + high) / 2)
+	value, err := GORLogLoss(predictions, labels, scheme, temperature)
+	return temperature, value, err
+}
+
+/*
+	CalibrateGOR fits the GOR softmax temperature on labeled proteins and measures how well the reliability index
+	separates correct from incorrect predictions.
+
+Input: a Predictor (its GOR tables and decision constants are used), labeled proteins the GOR tables were not
+estimated from, and the reduction scheme applied to both labels and predictions.
+Output: the fitted temperature with its log loss and that of DefaultGORTemperature, and per reliability index the
+number of residues, how many were predicted correctly and their mean probability of the predicted class; or an error
+naming a protein that cannot be predicted.
+*/
+func CalibrateGOR(p *Predictor, proteins []LabeledProtein, scheme ReductionScheme) (GORCalibration, error) {
+	var calibration GORCalibration
+	var predictions []GORPredictionResult // Pooled over every protein
+	var labels strings.Builder
+	for _, protein := range proteins {
+		if len(protein.Sequence) != len(protein.Labels) {
+			return calibration, fmt.Errorf("protein %s: sequence length %d does not match label length %d", protein.Name, len(protein.Sequence), len(protein.Labels))
+		}
+		result := p.Predict(FASTARecord{ID: protein.Name, Sequence: protein.Sequence})
+		if result.Error != "" {
+			return calibration, fmt.Errorf("protein %s: %s", protein.Name, result.Error)
+		}
+		predictions = append(predictions, result.GORScores...)
+		labels.WriteString(scheme.Reduce(protein.Labels))
+	}
+
+	var err error
+	if calibration.DefaultLogLoss, err = GORLogLoss(predictions, labels.String(), scheme, DefaultGORTemperature); err != nil {
+		return calibration, err
+	}
+	if calibration.Temperature, calibration.LogLoss, err = FitGORTemperature(predictions, labels.String(), scheme); err != nil {
+		return calibration, err
+	}
+	calibration.Residues = len(predictions)
+
+	// Tally the residues of each reliability index under the fitted temperature
+	SetGORProbabilities(predictions, calibration.Temperature)
+	actual := labels.String()
+	for i, prediction := range predictions {
+		bin := &calibration.Reliability[prediction.Reliability]
+		bin.Residues++
+		if scheme.Reduce(prediction.PredictedStructure)[0] == actual[i] {
+			bin.Correct++
+		}
+		probs := gorProbabilities(prediction, calibration.Temperature)
+		bin.MeanProbability += probs[strings.Index(string(gorStructures), prediction.PredictedStructure)]
+	}
+	for i := range calibration.Reliability {
+		if bin := &calibration.Reliability[i]; bin.Residues > 0 {
+			bin.MeanProbability /= float64(bin.Residues)
+		}
+	}
+	return calibration, nil
+}
+
+// WriteGORCalibration()
+// Input: a writer and the calibration
+// Output: an error if writing fails
+// Writes the fitted temperature, the log losses and a table of accuracy against reliability index.
+func WriteGORCalibration(w io.Writer, c GORCalibration) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Fitted GOR temperature on %d residues: %.1f centinats (use -gor-temperature %.1f)\n", c.Residues, c.Temperature, c.Temperature)
+	fmt.Fprintf(&b, "Log loss: %.4f at the default temperature %.0f, %.4f at the fitted temperature\n\n", c.DefaultLogLoss, DefaultGORTemperature, c.LogLoss)
+	fmt.Fprintf(&b, "%-12s %8s %10s %16s\n", "Reliability", "Residues", "Accuracy", "Mean probability")
+	for ri, bin := range c.Reliability {
+		if bin.Residues == 0 {
+			fmt.Fprintf(&b, "%-12d %8d %10s %16s\n", ri, 0, "-", "-")
+			continue
+		}
+		fmt.Fprintf(&b, "%-12d %8d %9.2f%% %16.3f\n", ri, bin.Residues, 100*float64(bin.Correct)/float64(bin.Residues), bin.MeanProbability)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
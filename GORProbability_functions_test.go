@@ -0,0 +1,135 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestSetGORProbabilities(t *testing.T) {
+	tests := []struct {
+		name        string
+		prediction  GORPredictionResult
+		temperature float64
+		probs       [4]float64
+		reliability int
+	}{
+		{"equal scores", GORPredictionResult{ScoreAlpha: 5, ScoreBeta: 5, ScoreTurn: 5, ScoreCoil: 5}, 0, [4]float64{0.25, 0.25, 0.25, 0.25}, 0},
+		{"default temperature", GORPredictionResult{ScoreAlpha: 100 * math.Log(3), ScoreBeta: 0, ScoreTurn: 0, ScoreCoil: 0}, 0, [4]float64{0.5, 1.0 / 6, 1.0 / 6, 1.0 / 6}, 3},
+		{"fitted temperature", GORPredictionResult{ScoreAlpha: 0, ScoreBeta: 0, ScoreTurn: 0, ScoreCoil: 200 * math.Log(3)}, 200, [4]float64{1.0 / 6, 1.0 / 6, 1.0 / 6, 0.5}, 3},
+		{"large scores", GORPredictionResult{ScoreAlpha: 1e6, ScoreBeta: 0, ScoreTurn: 0, ScoreCoil: 0}, 100, [4]float64{1, 0, 0, 0}, 9},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			predictions := []GORPredictionResult{test.prediction}
+			SetGORProbabilities(predictions, test.temperature)
+			p := predictions[0]
+			got := [4]float64{p.ProbAlpha, p.ProbBeta, p.ProbTurn, p.ProbCoil}
+			for k := range got {
+				if !floatEquals(got[k], test.probs[k], 1e-9) {
+					t.Errorf("Expected probabilities %v, got %v", test.probs, got)
+					break
+				}
+			}
+			if p.Reliability != test.reliability {
+				t.Errorf("Expected reliability %d, got %d", test.reliability, p.Reliability)
+			}
+		})
+	}
+}
+
+func TestGORLogLoss(t *testing.T) {
+	predictions := []GORPredictionResult{{ScoreAlpha: 100 * math.Log(3)}, {ScoreAlpha: 100 * math.Log(3)}}
+	hetc, _ := ParseReductionScheme("hetc")
+	hec, _ := ParseReductionScheme("hec")
+
+	loss, err := GORLogLoss(predictions, "HC", hetc, 100)
+	if err != nil {
+		t.Fatalf("GORLogLoss returned error: %v", err)
+	}
+	if expected := (math.Log(2) + math.Log(6)) / 2; !floatEquals(loss, expected, 1e-9) {
+		t.Errorf("Expected log loss %v, got %v", expected, loss)
+	}
+
+	// Under hec the turn probability counts toward coil
+	loss, err = GORLogLoss(predictions, "HC", hec, 100)
+	if err != nil {
+		t.Fatalf("GORLogLoss returned error: %v", err)
+	}
+	if expected := (math.Log(2) + math.Log(3)) / 2; !floatEquals(loss, expected, 1e-9) {
+		t.Errorf("Expected log loss %v, got %v", expected, loss)
+	}
+
+	if _, err := GORLogLoss(predictions, "HT", hec, 100); err == nil || !strings.Contains(err.Error(), "not a class GOR predicts") {
+		t.Errorf("Expected an error for a label outside the reduction, got %v", err)
+	}
+	if _, err := GORLogLoss(predictions, "H", hetc, 100); err == nil {
+		t.Errorf("Expected an error for mismatched lengths")
+	}
+}
+
+func TestFitGORTemperature(t *testing.T) {
+	// At a temperature of 200 these scores give H a probability of 2/5 and the other classes 1/5 each, the
+	// frequencies of the labels, so 200 is the maximum-likelihood temperature
+	var predictions []GORPredictionResult
+	var labels string
+	for i := 0; i < 20; i++ {
+		for _, label := range "HHETC" {
+			predictions = append(predictions, GORPredictionResult{ScoreAlpha: 200 * math.Log(2)})
+			labels += string(label)
+		}
+	}
+	hetc, _ := ParseReductionScheme("hetc")
+
+	temperature, loss, err := FitGORTemperature(predictions, labels, hetc)
+	if err != nil {
+		t.Fatalf("FitGORTemperature returned error: %v", err)
+	}
+	if !floatEquals(temperature, 200, 0.01) {
+		t.Errorf("Expected temperature 200, got %v", temperature)
+	}
+	if expected := -(0.4*math.Log(0.4) + 0.6*math.Log(0.2)); !floatEquals(loss, expected, 1e-6) {
+		t.Errorf("Expected log loss %v, got %v", expected, loss)
+	}
+}
+
+func TestGORCalibrateCommand(t *testing.T) {
+	var out bytes.Buffer
+	if err := RunCLI([]string{"gor-calibrate"}, &out); err != nil {
+		t.Fatalf("gor-calibrate returned error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if !strings.HasPrefix(lines[0], "Fitted GOR temperature on ") || len(lines) != 14 {
+		t.Errorf("Expected the fitted temperature and one row per reliability index, got:\n%s", out.String())
+	}
+
+	// Predictions carry probabilities at the temperature given with -gor-temperature
+	sequence := "MKTAYIAKQRQISFVKSHFSRQLEERLGLIEVQ"
+	var results [2][]PredictionResult
+	for i, temperature := range []string{"100", "1000"} {
+		out.Reset()
+		if err := RunCLI([]string{"predict", "-format", "json", "-gor-temperature", temperature, sequence}, &out); err != nil {
+			t.Fatalf("predict returned error: %v", err)
+		}
+		if err := json.Unmarshal(out.Bytes(), &results[i]); err != nil {
+			t.Fatalf("Output is not valid JSON: %v", err)
+		}
+	}
+	for i, score := range results[0][0].GORScores {
+		if sum := score.ProbAlpha + score.ProbBeta + score.ProbTurn + score.ProbCoil; !floatEquals(sum, 1, 1e-9) {
+			t.Errorf("Residue %d: probabilities sum to %v", i+1, sum)
+		}
+		if flatter := results[1][0].GORScores[i]; flatter.Reliability > score.Reliability {
+			t.Errorf("Residue %d: expected a higher temperature not to raise the reliability (%d > %d)", i+1, flatter.Reliability, score.Reliability)
+		}
+	}
+
+	if err := RunCLI([]string{"predict", "-gor-temperature", "-5", sequence}, &out); err == nil {
+		t.Errorf("Expected an error for a negative temperature")
+	}
+}
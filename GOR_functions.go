@@ -8,18 +8,31 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
+	"math"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 )
 
+// gorFiles names the GOR information table of each class in gorStructures
+var gorFiles = []string{"InfoVal_aHelix.csv", "InfoVal_bStrand.csv", "InfoVal_bTurn.csv", "InfoVal_Coil.csv"}
+
+// gorResidueOrder is the row order of the GOR tables in GOR_InfoVals, with the all-zero X row last
+var gorResidueOrder = []string{"G", "A", "V", "L", "I", "S", "T", "D", "E", "N", "Q", "K", "H", "R", "F", "Y", "W", "C", "M", "P", "X"}
+
+// gorAbsentInformation is the information, in centinats, written for a structure that has no residues in the
+// training labels (e.g. turns under the hec reduction), so that it is never predicted
+const gorAbsentInformation = -1000
+
 /*******************FUNCTIONS*********************/
 
 /*
 	ReadGORParameters reads GOR parameters from a CSV file.
 
 Input: Filename (string) of the .csv file.
-Output: Returns a map with key strings and float64 slice. Amino acid are single-letter codes and values of floats represent the information values at positions -8 to +8 relative to the central residue.
+Output: Returns a map with key strings and float64 slice. Amino acid are single-letter codes and values of floats represent the information values at the offsets of the header row (-8 to +8 relative to the central residue for the standard window).
+Returns an error naming the file if the table is malformed (see ParseGORParameters).
 */
 func ReadGORParameters(filename string) (InfoValTable, error) {
 	// Open the CSV file
@@ -29,15 +42,39 @@ func ReadGORParameters(filename string) (InfoValTable, error) {
 	}
 	defer file.Close()
 
+	params, err := ParseGORParameters(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return params, nil
+}
+
+/*
+	ParseGORParameters reads a GOR information table from CSV text.
+
+Input: an io.Reader with a header row Position,-h,...,h followed by one row per residue.
+Output: the InfoValTable, or an error if the header offsets are not -h..h in steps of 1, a row does not have one
+value per offset, a residue appears twice, or a row for one of the 20 standard amino acids or X (the fallback for
+unknown residues) is missing. The window width is the number of offsets in the header.
+*/
+func ParseGORParameters(r io.Reader) (InfoValTable, error) {
 	// Create a new CSV reader
-	reader := csv.NewReader(file)
+	reader := csv.NewReader(r)
 	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1 // Row lengths are checked below with a clearer message
 
-	// Read the header line to skip it
-	_, err = reader.Read()
+	// Read the header line to find the window
+	header, err := reader.Read()
 	if err != nil {
 		return nil, fmt.Errorf("failed to read header: %v", err)
 	}
+	if label := strings.TrimPrefix(header[0], "\ufeff"); label != "Position" {
+		return nil, fmt.Errorf("header must start with Position, got %q", label)
+	}
+	windowSize, err := parseGOROffsets(header[1:])
+	if err != nil {
+		return nil, err
+	}
 
 	// Initialize the parameters map
 	params := make(map[string][]float64)
@@ -54,13 +91,19 @@ func ReadGORParameters(filename string) (InfoValTable, error) {
 
 		// The first field is the amino acid code
 		aa := record[0]
+		if _, seen := params[aa]; seen {
+			return nil, fmt.Errorf("duplicate row for %s", aa)
+		}
+		if len(record)-1 != windowSize {
+			return nil, fmt.Errorf("row %s has %d values but the header has %d offsets", aa, len(record)-1, windowSize)
+		}
 
 		// The rest are the parameter values
-		values := make([]float64, 0, 17) // There are 17 positions from -8 to +8
-		for _, valStr := range record[1:] {
+		values := make([]float64, 0, windowSize)
+		for k, valStr := range record[1:] {
 			val, err := strconv.ParseFloat(valStr, 64)
 			if err != nil {
-				return nil, fmt.Errorf("failed to parse float value %s: %v", valStr, err)
+				return nil, fmt.Errorf("row %s: invalid value %q at offset %s", aa, valStr, header[k+1])
 			}
 			values = append(values, val)
 		}
@@ -69,19 +112,82 @@ func ReadGORParameters(filename string) (InfoValTable, error) {
 		params[aa] = values
 	}
 
+	if missing := missingGORRows(params); len(missing) > 0 {
+		return nil, fmt.Errorf("missing rows for %s (every standard amino acid and X, the fallback for unknown residues, is required)", strings.Join(missing, ", "))
+	}
 	return params, nil
 }
 
+// parseGOROffsets()
+// Input: the offset columns of a GOR table header, e.g. -8,...,8
+// Output: the window size, or an error unless the offsets run from -h to h in steps of 1
+func parseGOROffsets(offsets []string) (int, error) {
+	windowSize := len(offsets)
+	if windowSize%2 == 0 {
+		return 0, fmt.Errorf("header has %d offsets; a window needs an odd number centred on 0", windowSize)
+	}
+	halfWindow := windowSize / 2
+	for k, field := range offsets {
+		offset, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(field), "+"))
+		if err != nil || offset != k-halfWindow {
+			return 0, fmt.Errorf("header offsets must run from %d to %d in steps of 1, got %q at column %d", -halfWindow, halfWindow, field, k+2)
+		}
+	}
+	return windowSize, nil
+}
+
+// missingGORRows returns the residues of gorResidueOrder that have no row in the table
+func missingGORRows(params InfoValTable) []string {
+	var missing []string
+	for _, aa := range gorResidueOrder {
+		if _, ok := params[aa]; !ok {
+			missing = append(missing, aa)
+		}
+	}
+	return missing
+}
+
+/*
+	ValidateGORTables checks that the four GOR tables can be used together.
+
+Input: the alpha-helix, beta-strand, turn and coil information tables.
+Output: an error if a table misses a required row, its rows differ in length, or the tables disagree on the window width.
+*/
+func ValidateGORTables(alphaParams, betaParams, turnParams, coilParams InfoValTable) error {
+	names := []string{"alpha", "beta", "turn", "coil"}
+	widths := make([]int, 4)
+	for t, table := range []InfoValTable{alphaParams, betaParams, turnParams, coilParams} {
+		if missing := missingGORRows(table); len(missing) > 0 {
+			return fmt.Errorf("%s table is missing rows for %s", names[t], strings.Join(missing, ", "))
+		}
+		widths[t] = len(table["X"])
+		for aa, values := range table {
+			if len(values) != widths[t] {
+				return fmt.Errorf("%s table row %s has %d values but row X has %d", names[t], aa, len(values), widths[t])
+			}
+		}
+		if widths[t]%2 == 0 {
+			return fmt.Errorf("%s table has an even window width %d", names[t], widths[t])
+		}
+	}
+	for t := 1; t < len(widths); t++ {
+		if widths[t] != widths[0] {
+			return fmt.Errorf("tables disagree on the window width: alpha %d, beta %d, turn %d, coil %d", widths[0], widths[1], widths[2], widths[3])
+		}
+	}
+	return nil
+}
+
 /*
 	GORPredict predicts the secondary structure of a protein sequence using the GOR method and the provided parameters for each structure type. It returns a slice of predicted structures corresponding to each residue.
 
-Input: Protein sequence of type string, alpha-helix, beta sheet, turn, and coil information value tables.
+Input: Protein sequence of type string, alpha-helix, beta sheet, turn, and coil information value tables, which must
+pass ValidateGORTables (NewPredictor checks them when it loads them, so prediction does not repeat the check).
 Output: Returns a slice of predicted structures.
 */
 func GORPredict(sequence string, alphaParams, betaParams, turnParams, coilParams InfoValTable) ([]GORPredictionResult, error) {
-
-	// Set the window size for this GOR method.
-	windowSize := 17 // Positions from -8 to +8
+	// The window size is given by the tables (17 for positions -8 to +8)
+	windowSize := len(alphaParams["X"])
 
 	seqLen := len(sequence) // Length of the given protein sequence
 	// Create a slice of GORPredictionResult objects that is equal to the length of the sequence.
@@ -94,22 +200,6 @@ func GORPredict(sequence string, alphaParams, betaParams, turnParams, coilParams
 		// Calculate scores for each structure within a sliding window
 		scoreAlpha, scoreBeta, scoreTurn, scoreCoil := SlideWindow(sequence, seqLen, windowSize, i, alphaParams, betaParams, turnParams, coilParams)
 
-		// Determine the structure with the highest score
-		maxScore := scoreAlpha
-		structure := "H" // Helix by default
-
-		if scoreBeta > maxScore {
-			maxScore = scoreBeta
-			structure = "E" // Beta-strand
-		}
-		if scoreTurn > maxScore {
-			maxScore = scoreTurn
-			structure = "T" // Turn
-		}
-		if scoreCoil > maxScore {
-			maxScore = scoreCoil
-			structure = "C" // Coil
-		}
 		// Store the prediction result
 		predictions[i] = GORPredictionResult{
 			Position:           i + 1, // Positions starting from 1
@@ -118,65 +208,84 @@ func GORPredict(sequence string, alphaParams, betaParams, turnParams, coilParams
 			ScoreBeta:          scoreBeta,
 			ScoreTurn:          scoreTurn,
 			ScoreCoil:          scoreCoil,
-			PredictedStructure: structure,
+			PredictedStructure: gorStructure(scoreAlpha, scoreBeta, scoreTurn, scoreCoil),
 		}
 	}
 
 	return predictions, nil
 }
 
+// gorStructure returns the structure with the highest score; ties go to the earlier of H, E, T and C
+func gorStructure(scoreAlpha, scoreBeta, scoreTurn, scoreCoil float64) string {
+	maxScore := scoreAlpha
+	structure := "H" // Helix by default
+
+	if scoreBeta > maxScore {
+		maxScore = scoreBeta
+		structure = "E" // Beta-strand
+	}
+	if scoreTurn > maxScore {
+		maxScore = scoreTurn
+		structure = "T" // Turn
+	}
+	if scoreCoil > maxScore {
+		maxScore = scoreCoil
+		structure = "C" // Coil
+	}
+	return structure
+}
+
 /*
 	SlideWindow(): Returns four float64 values that represent four information scores for the alpha helix, beta sheet, turn, and coil structures.
 
 Input: A string object of the entire protein sequence, three integers representing the length of the protein sequence, size of the reading window, index of the central residue of the window, and four InfoValTable (map) objects that contain the slices floats as the values to the amino acid single-letter keys.
+The tables are indexed without checks: they must pass ValidateGORTables, with rows as long as the window size.
 Output: Four float64 values representing the calculated informations scores for the four structures.
 */
 func SlideWindow(sequence string, seqLen, windowSize, i int, alphaParams, betaParams, turnParams, coilParams InfoValTable) (float64, float64, float64, float64) {
-	halfWindow := windowSize / 2 // half the size of a given reading window.
-
-	// Initialize scores for each structure type
-	scoreAlpha, scoreBeta, scoreTurn, scoreCoil := 0.0, 0.0, 0.0, 0.0
+	// Sum the information value of every position in the window; positions outside the sequence add nothing
+	contributions := gorContributions(sequence, seqLen, windowSize, i, GOREdgeSkip, alphaParams, betaParams, turnParams, coilParams)
+	scores, _ := sumGORContributions(contributions, GOREdgeSkip)
+	return scores[0], scores[1], scores[2], scores[3]
+}
 
-	// Slide the reading window over the sequence
-	for pos := -halfWindow; pos <= halfWindow; pos++ { // Ranges from -8 through 8 indices within the window
-		windowIndex := i + pos // Calculate the current index in the window based on the central residue.
+/*
+	gorContributions(): Returns the values each position in the reading window of residue i adds to the four information scores.
 
-		// Check if windowIndex is within bounds
-		if windowIndex < 0 || windowIndex >= seqLen {
-			continue // Skip positions outside the sequence
+Input: the protein sequence, its length, the window size, the index of the central residue, the edge mode (see
+GOREdgeModes) and the four InfoValTable objects. Residues missing from the alpha table use the X row of every table.
+Output: one GORContribution per window offset from -windowSize/2 to windowSize/2. Positions beyond the ends of the
+sequence add nothing, or the values of the terminus row ("-") in the terminus edge mode.
+*/
+func gorContributions(sequence string, seqLen, windowSize, i int, edge string, alphaParams, betaParams, turnParams, coilParams InfoValTable) []GORContribution {
+	halfWindow := windowSize / 2
+	tables := []InfoValTable{alphaParams, betaParams, turnParams, coilParams}
+
+	contributions := make([]GORContribution, 0, windowSize)
+	for pos := -halfWindow; pos <= halfWindow; pos++ {
+		windowIndex := i + pos
+		paramIndex := pos + halfWindow // Column of the information value in a row
+
+		row := ""
+		switch {
+		case windowIndex >= 0 && windowIndex < seqLen:
+			row = string(sequence[windowIndex])
+			if _, ok := alphaParams[row]; !ok {
+				row = "X" // Unknown residues use the X rows (zero values)
+			}
+		case edge == GOREdgeTerminus:
+			row = gorTerminusRow
 		}
 
-		// Get the amino acid at the window position (window position is the true index in protein seq.)
-		aa := string(sequence[windowIndex])
-
-		// Get the position index for parameters (0 to 16 indices in the slice of information values (column) in the information value table.)
-		paramIndex := pos + halfWindow // Calculates the parameter index i.e. information value in a given row.
-
-		// Get the information values for the amino acid
-		alphaVals, ok := alphaParams[aa] // Finds the row for this amino acid at the index for the loop.
-
-		var betaVals, turnVals, coilVals []float64
-		// If the amino acid, "aa", does not appear in the map, use the last row's values (row X) from the InfoValTable (zero values).
-		if !ok {
-			alphaVals = alphaParams["X"]
-			betaVals = betaParams["X"]
-			turnVals = turnParams["X"]
-			coilVals = coilParams["X"]
-
-		} else { // Set other structure's values
-			betaVals = betaParams[aa]
-			turnVals = turnParams[aa]
-			coilVals = coilParams[aa]
+		var values [4]float64
+		if row != "" {
+			for t, table := range tables {
+				values[t] = table[row][paramIndex]
+			}
 		}
-
-		// Accumulate scores
-		scoreAlpha += alphaVals[paramIndex]
-		scoreBeta += betaVals[paramIndex]
-		scoreTurn += turnVals[paramIndex]
-		scoreCoil += coilVals[paramIndex]
+		contributions = append(contributions, newGORContribution(sequence, seqLen, i, pos, values))
 	}
-
-	return scoreAlpha, scoreBeta, scoreTurn, scoreCoil
+	return contributions
 }
 
 /*
@@ -271,3 +380,213 @@ func OutputGORSequence(predictions []GORPredictionResult) string {
 	resultString := strings.Join(result, "")
 	return resultString
 }
+
+/*
+	EstimateGORParameters computes GOR I information values from residue and label counts over a labeled dataset.
+
+Input: labeled proteins with H, E, T and C labels, the window size (17 for offsets -8 to +8) and the pseudocount added to every count.
+Output: the alpha-helix, beta-strand, turn and coil tables, in that order, in centinats (hundredths of a nat), or an error
+if a protein has mismatched lengths or an unknown label, or there is nothing to count.
+The value of residue R at offset m for structure S is the directional information
+I(S; R at m) = ln[f(S, R@m) / f(n-S, R@m)] - ln[f(S, .@m) / f(n-S, .@m)],
+where f(S, R@m) counts the residues in structure S that have R at offset m and n-S is every other structure.
+Residues other than the 20 standard amino acids are not counted; the X row is zero. Positions beyond the ends of a
+sequence are counted in the terminus row ("-"), used by the terminus edge mode (see GORPredictEdge), whose value at
+offset m is ln[f(S, -@m) / f(n-S, -@m)] - ln[f(S) / f(n-S)] over all residues of S and n-S.
+A structure without training residues gets gorAbsentInformation at offset 0, so it is never predicted.
+*/
+func EstimateGORParameters(proteins []LabeledProtein, windowSize int, pseudocount float64) ([]InfoValTable, error) {
+	if pseudocount < 0 {
+		return nil, fmt.Errorf("pseudocount must not be negative, got %g", pseudocount)
+	}
+	if windowSize < 1 || windowSize%2 == 0 {
+		return nil, fmt.Errorf("window size must be a positive odd number, got %d", windowSize)
+	}
+	halfWindow := windowSize / 2
+	nAA, nS := len(AminoAcidSymbols), len(gorStructures)
+	aaIndex := make(map[byte]int, nAA)
+	for i, aa := range AminoAcidSymbols {
+		aaIndex[aa[0]] = i
+	}
+	sIndex := make(map[byte]int, nS)
+	for i, s := range gorStructures {
+		sIndex[s] = i
+	}
+
+	// counts[s][a][k] counts residues in structure s that have amino acid a at offset k-halfWindow
+	counts := make([][][]float64, nS)
+	for s := range counts {
+		counts[s] = make([][]float64, nAA)
+		for a := range counts[s] {
+			counts[s][a] = make([]float64, windowSize)
+		}
+	}
+
+	residues := make([]float64, nS)   // Residues of each structure
+	terminus := make([][]float64, nS) // terminus[s][k] counts residues in structure s with offset k-halfWindow beyond an end
+	for s := range terminus {
+		terminus[s] = make([]float64, windowSize)
+	}
+	for _, protein := range proteins {
+		if len(protein.Sequence) != len(protein.Labels) {
+			return nil, fmt.Errorf("%s: sequence has %d residues but %d labels", protein.Name, len(protein.Sequence), len(protein.Labels))
+		}
+		for i := 0; i < len(protein.Sequence); i++ {
+			s, ok := sIndex[protein.Labels[i]]
+			if !ok {
+				return nil, fmt.Errorf("%s: unknown label %c at position %d (expected H, E, T or C)", protein.Name, protein.Labels[i], i+1)
+			}
+			residues[s]++
+			for pos := -halfWindow; pos <= halfWindow; pos++ {
+				j := i + pos
+				if j < 0 || j >= len(protein.Sequence) {
+					terminus[s][pos+halfWindow]++ // Outside the sequence
+					continue
+				}
+				if a, ok := aaIndex[protein.Sequence[j]]; ok {
+					counts[s][a][pos+halfWindow]++
+				}
+			}
+		}
+	}
+	total := 0.0
+	for _, n := range residues {
+		total += n
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("no labeled residues to estimate GOR parameters from")
+	}
+
+	// margins[s][k] counts the residues in structure s with a standard amino acid at offset k-halfWindow
+	margins := make([][]float64, nS)
+	for s := range margins {
+		margins[s] = make([]float64, windowSize)
+		for a := range counts[s] {
+			for k, n := range counts[s][a] {
+				margins[s][k] += n
+			}
+		}
+	}
+
+	tables := make([]InfoValTable, nS)
+	for s := range tables {
+		table := make(InfoValTable)
+		for a, aa := range AminoAcidSymbols {
+			values := make([]float64, windowSize)
+			for k := range values {
+				inS, notS, marginS, notMargin := counts[s][a][k], 0.0, margins[s][k], 0.0
+				for other := range counts {
+					if other != s {
+						notS += counts[other][a][k]
+						notMargin += margins[other][k]
+					}
+				}
+				values[k] = informationCentinats(inS, notS, pseudocount) - informationCentinats(marginS, notMargin, float64(nAA)*pseudocount)
+			}
+			if residues[s] == 0 {
+				values = make([]float64, windowSize) // Structure not in the training labels
+				values[halfWindow] = gorAbsentInformation
+			}
+			table[aa] = values
+		}
+		table["X"] = make([]float64, windowSize)
+
+		// The terminus pseudo-residue, for the terminus edge mode, relative to every residue of the structure
+		values := make([]float64, windowSize)
+		if residues[s] > 0 {
+			for k := range values {
+				notS := 0.0
+				for other := range terminus {
+					if other != s {
+						notS += terminus[other][k]
+					}
+				}
+				values[k] = informationCentinats(terminus[s][k], notS, pseudocount) - informationCentinats(residues[s], total-residues[s], float64(nAA+1)*pseudocount)
+			}
+			values[halfWindow] = 0 // The central residue is never beyond an end
+		}
+		table[gorTerminusRow] = values
+		tables[s] = table
+	}
+	return tables, nil
+}
+
+// informationCentinats returns the information 100 ln[(x + c) / (y + c)] in centinats, or 0 if either side is empty
+func informationCentinats(x, y, c float64) float64 {
+	if x+c == 0 || y+c == 0 {
+		return 0 // Never observed: no information
+	}
+	return 100 * math.Log((x+c)/(y+c))
+}
+
+/*
+	WriteGORParameters writes one GOR information table in the CSV layout read by ReadGORParameters.
+
+Input: a writer and the table; the window size is given by the length of its X row.
+Output: an error if the table has no X row, a row of another length, or writing fails.
+Rows follow the residue order of GOR_InfoVals (G, A, V, ..., P, X), then the terminus row if the table has one, and
+values are rounded to whole centinats.
+*/
+func WriteGORParameters(w io.Writer, table InfoValTable) error {
+	reference, ok := table["X"]
+	if !ok {
+		return fmt.Errorf("GOR table has no X row")
+	}
+	windowSize := len(reference)
+	for aa, values := range table {
+		if len(values) != windowSize {
+			return fmt.Errorf("GOR table row %s has %d values, expected %d like the X row", aa, len(values), windowSize)
+		}
+	}
+	halfWindow := windowSize / 2
+
+	writer := csv.NewWriter(w)
+	header := []string{"Position"}
+	for pos := -halfWindow; pos <= halfWindow; pos++ {
+		header = append(header, strconv.Itoa(pos))
+	}
+	writer.Write(header)
+	for _, aa := range append(gorResidueOrder, gorTerminusRow) {
+		values, ok := table[aa]
+		if !ok {
+			continue
+		}
+		row := []string{aa}
+		for _, v := range values {
+			v = math.Round(v)
+			if v == 0 {
+				v = 0 // Values in (-0.5, 0) round to -0, which would be written as "-0"
+			}
+			row = append(row, strconv.FormatFloat(v, 'f', 0, 64))
+		}
+		writer.Write(row)
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+/*
+	SaveGORParameters writes the four GOR information tables to a directory, under the file names NewPredictor reads.
+
+Input: the directory and the alpha-helix, beta-strand, turn and coil tables, in that order.
+Output: an error if the directory or a file cannot be written.
+*/
+func SaveGORParameters(dir string, tables []InfoValTable) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for i, name := range gorFiles {
+		file, err := os.Create(filepath.Join(dir, name))
+		if err != nil {
+			return err
+		}
+		if err := WriteGORParameters(file, tables[i]); err != nil {
+			file.Close()
+			return err
+		}
+		if err := file.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
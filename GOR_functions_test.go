@@ -6,10 +6,12 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/csv"
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -565,3 +567,198 @@ func TestGORPredict(t *testing.T) {
 		}
 	}
 }
+
+/******************************************************************************************************
+TEST ESTIMATEGORPARAMETERS()
+******************************************************************************************************/
+
+func TestEstimateGORParameters(t *testing.T) {
+	// Window of 3: offsets -1, 0, +1
+	proteins := []LabeledProtein{{Name: "p1", Sequence: "AG", Labels: "HC"}}
+	tables, err := EstimateGORParameters(proteins, 3, 1)
+	if err != nil {
+		t.Fatalf("EstimateGORParameters returned error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		got      float64
+		expected float64
+	}{
+		{"helix A at 0", tables[0]["A"][1], 100 * math.Log(2)},
+		{"helix G at +1", tables[0]["G"][2], 100*math.Log(2) - 100*math.Log(21.0/20)},
+		{"helix A at -1", tables[0]["A"][0], 100*math.Log(0.5) - 100*math.Log(20.0/21)},
+		{"coil G at 0", tables[3]["G"][1], 100 * math.Log(2)},
+		{"helix X", tables[0]["X"][1], 0},
+		{"absent strand at 0", tables[1]["A"][1], gorAbsentInformation},
+		{"absent turn at +1", tables[2]["G"][2], 0},
+	}
+	for _, test := range tests {
+		if !floatEquals(test.got, test.expected, 1e-9) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, test.got)
+		}
+	}
+
+	// The written tables are read back by ReadGORParameters, rounded to whole centinats
+	dir := t.TempDir()
+	if err := SaveGORParameters(dir, tables); err != nil {
+		t.Fatalf("SaveGORParameters returned error: %v", err)
+	}
+	content, err := os.ReadFile(filepath.Join(dir, "InfoVal_aHelix.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(content), "Position,-1,0,1\nG,") {
+		t.Errorf("Unexpected table layout:\n%s", content)
+	}
+	helix, err := ReadGORParameters(filepath.Join(dir, "InfoVal_aHelix.csv"))
+	if err != nil {
+		t.Fatalf("ReadGORParameters returned error: %v", err)
+	}
+	if len(helix) != 22 || helix["A"][1] != 69 || helix["X"][1] != 0 || helix[gorTerminusRow][1] != 0 {
+		t.Errorf("Unexpected table read back: %d rows, A = %v", len(helix), helix["A"])
+	}
+}
+
+func TestWriteGORParameters(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteGORParameters(&buf, InfoValTable{"A": {-0.4, 12.6, -3}, "X": {0, 0, 0}}); err != nil {
+		t.Fatalf("WriteGORParameters returned error: %v", err)
+	}
+	if expected := "Position,-1,0,1\nA,0,13,-3\nX,0,0,0\n"; buf.String() != expected {
+		t.Errorf("Expected %q but got %q", expected, buf.String())
+	}
+
+	for _, table := range []InfoValTable{{"A": {1, 2, 3}}, {"A": {1, 2, 3}, "X": {0, 0, 0, 0, 0}}} {
+		if err := WriteGORParameters(&buf, table); err == nil {
+			t.Errorf("Expected an error for %v", table)
+		}
+	}
+}
+
+func TestGORTrainCommand(t *testing.T) {
+	dir := t.TempDir()
+	var out bytes.Buffer
+	if err := RunCLI([]string{"gor-train", "-reduce", "hec", "-out", dir}, &out); err != nil {
+		t.Fatalf("gor-train returned error: %v", err)
+	}
+	if !strings.Contains(out.String(), "from 50 proteins (reduction hec)") {
+		t.Errorf("Unexpected output: %s", out.String())
+	}
+
+	// Without turn labels in training, turns are never predicted
+	out.Reset()
+	if err := RunCLI([]string{"predict", "-gor-dir", dir, "-format", "tsv", "GPNGTDPSGKPGNGSTDNPYG"}, &out); err != nil {
+		t.Fatalf("predict returned error: %v", err)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n")[1:] {
+		if fields := strings.Split(line, "\t"); fields[5] == "T" {
+			t.Errorf("Unexpected turn prediction: %s", line)
+		}
+	}
+
+	if err := RunCLI([]string{"gor-train", "-reduce", "HGI:H,EB:E,*:Q", "-out", dir}, &out); err == nil || !strings.Contains(err.Error(), "class Q") {
+		t.Errorf("Expected an error for class Q, got %v", err)
+	}
+	if err := RunCLI([]string{"gor-train", "-method", "gor4", "-out", dir}, &out); err == nil || !strings.Contains(err.Error(), "unknown method") {
+		t.Errorf("Expected an unknown method error, got %v", err)
+	}
+}
+
+/******************************************************************************************************
+TEST PARSEGORPARAMETERS() AND VALIDATEGORTABLES()
+******************************************************************************************************/
+
+// gorTableCSV returns a table with the given header offsets and the same value at every offset of every residue
+// row, leaving out the residues in skip
+func gorTableCSV(offsets string, value string, skip ...string) string {
+	width := len(strings.Split(offsets, ","))
+	var b strings.Builder
+	b.WriteString("Position," + offsets + "\n")
+	for _, aa := range gorResidueOrder {
+		skipped := false
+		for _, s := range skip {
+			skipped = skipped || s == aa
+		}
+		if !skipped {
+			b.WriteString(aa + strings.Repeat(","+value, width) + "\n")
+		}
+	}
+	return b.String()
+}
+
+func TestParseGORParameters(t *testing.T) {
+	table, err := ParseGORParameters(strings.NewReader("\ufeff" + gorTableCSV("-2,-1,0,1,2", "5")))
+	if err != nil {
+		t.Fatalf("ParseGORParameters returned error: %v", err)
+	}
+	if len(table) != 21 || len(table["X"]) != 5 {
+		t.Errorf("Expected 21 rows of 5 values, got %d rows, X = %v", len(table), table["X"])
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		message string
+	}{
+		{"bad header", strings.Replace(gorTableCSV("-1,0,1", "0"), "Position", "Residue", 1), `header must start with Position, got "Residue"`},
+		{"even window", gorTableCSV("-1,0,1,2", "0"), "header has 4 offsets"},
+		{"gap in offsets", gorTableCSV("-2,0,2", "0"), `header offsets must run from -1 to 1 in steps of 1, got "-2" at column 2`},
+		{"short row", gorTableCSV("-1,0,1", "0") + "Z,1,2\n", "row Z has 2 values but the header has 3 offsets"},
+		{"duplicate row", gorTableCSV("-1,0,1", "0") + "A,1,2,3\n", "duplicate row for A"},
+		{"missing X", gorTableCSV("-1,0,1", "0", "X"), "missing rows for X"},
+		{"missing residues", gorTableCSV("-1,0,1", "0", "A", "W"), "missing rows for A, W"},
+		{"bad value", gorTableCSV("-1,0,1", "abc"), `row G: invalid value "abc" at offset -1`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseGORParameters(strings.NewReader(test.content))
+			if err == nil || !strings.Contains(err.Error(), test.message) {
+				t.Errorf("Expected error containing %q, got %v", test.message, err)
+			}
+		})
+	}
+}
+
+func TestGORPredictWindowFromTables(t *testing.T) {
+	narrow, err := ParseGORParameters(strings.NewReader(gorTableCSV("-1,0,1", "1")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	zero, err := ParseGORParameters(strings.NewReader(gorTableCSV("-1,0,1", "0")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// With a window of 3, interior residues see 3 positions and the ends 2
+	predictions, err := GORPredict("AAAA", narrow, zero, zero, zero)
+	if err != nil {
+		t.Fatalf("GORPredict returned error: %v", err)
+	}
+	for i, expected := range []float64{2, 3, 3, 2} {
+		if predictions[i].ScoreAlpha != expected {
+			t.Errorf("Position %d: expected alpha score %v, got %v", i+1, expected, predictions[i].ScoreAlpha)
+		}
+	}
+
+	// Tables of different widths, or with short rows, are rejected before they reach GORPredict
+	wide, err := ParseGORParameters(strings.NewReader(gorTableCSV("-2,-1,0,1,2", "0")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateGORTables(narrow, wide, zero, zero); err == nil || !strings.Contains(err.Error(), "tables disagree on the window width: alpha 3, beta 5") {
+		t.Errorf("Expected a window width error, got %v", err)
+	}
+	broken := InfoValTable{}
+	for aa, values := range zero {
+		broken[aa] = values
+	}
+	broken["A"] = []float64{1}
+	if err := ValidateGORTables(zero, zero, broken, zero); err == nil || !strings.Contains(err.Error(), "turn table row A has 1 values but row X has 3") {
+		t.Errorf("Expected a row length error, got %v", err)
+	}
+	delete(broken, "X")
+	if err := ValidateGORTables(zero, zero, zero, broken); err == nil || !strings.Contains(err.Error(), "coil table is missing rows for X") {
+		t.Errorf("Expected a missing row error, got %v", err)
+	}
+}
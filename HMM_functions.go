@@ -5,7 +5,8 @@
 package main
 
 import (
-	"log"
+	"fmt"
+	"math"
 )
 
 // NewHMM initializes an HMM with the given states and symbols.
@@ -33,13 +34,70 @@ func NewHMM(states, symbols []string) *HMM {
 	}
 }
 
+// LoadProbabilities stores the given initial, transition and emission probabilities in the HMM.
+// The HMM keeps all parameters in log space, so each probability is converted with math.Log
+// (a zero probability becomes -Inf). The inputs are not modified.
+func (hmm *HMM) LoadProbabilities(initial []float64, transition, emission [][]float64) {
+	hmm.Initial = logVector(initial)
+	hmm.Transition = make([][]float64, len(transition))
+	for i, row := range transition {
+		hmm.Transition[i] = logVector(row)
+	}
+	hmm.Emission = make([][]float64, len(emission))
+	for i, row := range emission {
+		hmm.Emission[i] = logVector(row)
+	}
+}
+
+// Probabilities returns copies of the initial, transition and emission parameters converted
+// back from log space to probabilities.
+func (hmm *HMM) Probabilities() (initial []float64, transition, emission [][]float64) {
+	initial = expVector(hmm.Initial)
+	transition = make([][]float64, len(hmm.Transition))
+	for i, row := range hmm.Transition {
+		transition[i] = expVector(row)
+	}
+	emission = make([][]float64, len(hmm.Emission))
+	for i, row := range hmm.Emission {
+		emission[i] = expVector(row)
+	}
+	return initial, transition, emission
+}
+
+// logVector returns the element-wise natural logarithm of values
+func logVector(values []float64) []float64 {
+	logs := make([]float64, len(values))
+	for i, v := range values {
+		logs[i] = math.Log(v)
+	}
+	return logs
+}
+
+// expVector returns the element-wise exponential of values
+func expVector(values []float64) []float64 {
+	probs := make([]float64, len(values))
+	for i, v := range values {
+		probs[i] = math.Exp(v)
+	}
+	return probs
+}
+
 // Viterbi computes the most likely sequence of states for a given observation sequence.
-// It uses dynamic programming to find the optimal path through the HMM.
-func (hmm *HMM) Viterbi(sequence string) string {
+// It uses dynamic programming to find the optimal path through the HMM. Since the parameters are
+// stored as log probabilities, path scores are sums of logs, i.e. logs of the path probabilities.
+// It returns an error if the sequence contains a symbol the HMM does not emit.
+func (hmm *HMM) Viterbi(sequence string) (string, error) {
 	T := len(sequence)   // Length of the observation sequence
 	N := len(hmm.States) // Number of states in the HMM
+	observations, err := hmm.observationIndices(sequence)
+	if err != nil {
+		return "", err
+	}
+	if T == 0 {
+		return "", nil
+	}
 
-	// v[t][j] holds the highest probability of any path that ends in state j at time t.
+	// v[t][j] holds the highest log probability of any path that ends in state j at time t.
 	v := make([][]float64, T)
 	// backpointer[t][j] stores the previous state that led to state j at time t.
 	backpointer := make([][]int, T)
@@ -52,20 +110,15 @@ func (hmm *HMM) Viterbi(sequence string) string {
 
 	// Initialization step: Compute probabilities for the first observation.
 	for i := 0; i < N; i++ {
-		symbol := string(sequence[0]) // First symbol in the sequence
-		emissionIdx, ok := hmm.SymbolMapping[symbol]
-		if !ok {
-			log.Fatalf("Invalid symbol: %s", symbol) // Handle invalid symbols
-		}
-		v[0][i] = hmm.Initial[i] + hmm.Emission[i][emissionIdx] // Log probabilities
-		backpointer[0][i] = -1                                  // No previous state at the first step
+		v[0][i] = hmm.Initial[i] + hmm.Emission[i][observations[0]] // Log probabilities
+		backpointer[0][i] = -1                                      // No previous state at the first step
 	}
 
 	// Recursion step: Compute probabilities for subsequent observations.
 	for t := 1; t < T; t++ {
 		for j := 0; j < N; j++ {
-			maxVal := -1e9 // Initialize to a very low probability
-			maxIdx := -1   // Index of the best previous state
+			maxVal := math.Inf(-1) // Initialize to log(0)
+			maxIdx := 0            // Index of the best previous state (state 0 if every path is impossible)
 			for i := 0; i < N; i++ {
 				val := v[t-1][i] + hmm.Transition[i][j] // Transition from state i to state j
 				if val > maxVal {                       // Keep track of the best probability
@@ -73,20 +126,15 @@ func (hmm *HMM) Viterbi(sequence string) string {
 					maxIdx = i
 				}
 			}
-			symbol := string(sequence[t]) // Current symbol in the sequence
-			emissionIdx, ok := hmm.SymbolMapping[symbol]
-			if !ok {
-				log.Fatalf("Invalid symbol: %s", symbol) // Handle invalid symbols
-			}
-			v[t][j] = maxVal + hmm.Emission[j][emissionIdx] // Update probability for state j
-			backpointer[t][j] = maxIdx                      // Record the state that led to state j
+			v[t][j] = maxVal + hmm.Emission[j][observations[t]] // Update probability for state j
+			backpointer[t][j] = maxIdx                          // Record the state that led to state j
 		}
 	}
 
 	// Termination step: Backtrack to find the most likely sequence of states.
 	bestPath := make([]int, T) // Array to store the optimal path
 	lastState := 0             // Index of the last state in the best path
-	maxVal := -1e9             // Maximum probability of any path ending at the last time step
+	maxVal := math.Inf(-1)     // Maximum log probability of any path ending at the last time step
 	for i := 0; i < N; i++ {
 		if v[T-1][i] > maxVal {
 			maxVal = v[T-1][i]
@@ -106,5 +154,150 @@ func (hmm *HMM) Viterbi(sequence string) string {
 		result += string(hmm.States[stateIdx][0]) // Use the first letter of each state
 	}
 
-	return result // Return the predicted sequence of states
+	return result, nil // Return the predicted sequence of states
+}
+
+// observationIndices converts a sequence into symbol indices, returning an error for unknown symbols.
+func (hmm *HMM) observationIndices(sequence string) ([]int, error) {
+	observations := make([]int, len(sequence))
+	for t := 0; t < len(sequence); t++ {
+		symbol := string(sequence[t])
+		idx, ok := hmm.SymbolMapping[symbol]
+		if !ok {
+			return nil, fmt.Errorf("invalid symbol %s at position %d", symbol, t+1)
+		}
+		observations[t] = idx
+	}
+	return observations, nil
+}
+
+// Forward runs the scaled forward algorithm on a sequence.
+// alpha[t][i] is the probability of being in state i at position t given the first t+1 observations
+// (each row sums to 1), and scales[t] is the normalizing factor of row t. Scaling keeps the values in
+// range for long chains; the log-likelihood of the sequence is the sum of log(scales[t]).
+func (hmm *HMM) Forward(sequence string) (alpha [][]float64, scales []float64, err error) {
+	observations, err := hmm.observationIndices(sequence)
+	if err != nil {
+		return nil, nil, err
+	}
+	initial, transition, emission := hmm.Probabilities()
+	return hmm.scaledForward(observations, initial, transition, emission)
+}
+
+// Backward runs the backward algorithm using the scale factors returned by Forward for the same sequence,
+// so that alpha[t][i]*beta[t][i] is the posterior probability of state i at position t.
+func (hmm *HMM) Backward(sequence string, scales []float64) ([][]float64, error) {
+	observations, err := hmm.observationIndices(sequence)
+	if err != nil {
+		return nil, err
+	}
+	if len(scales) != len(observations) {
+		return nil, fmt.Errorf("expected %d scale factors, got %d", len(observations), len(scales))
+	}
+	_, transition, emission := hmm.Probabilities()
+	return hmm.scaledBackward(observations, scales, transition, emission), nil
+}
+
+// scaledForward is the forward pass of Forward on observation indices and plain (not log) probabilities.
+func (hmm *HMM) scaledForward(observations []int, initial []float64, transition, emission [][]float64) ([][]float64, []float64, error) {
+	T := len(observations) // Length of the observation sequence
+	N := len(hmm.States)   // Number of states in the HMM
+
+	alpha := make([][]float64, T)
+	scales := make([]float64, T)
+	for t := 0; t < T; t++ {
+		alpha[t] = make([]float64, N)
+		for j := 0; j < N; j++ {
+			if t == 0 {
+				alpha[t][j] = initial[j] // Start in state j
+			} else {
+				for i := 0; i < N; i++ {
+					alpha[t][j] += alpha[t-1][i] * transition[i][j] // Arrive in state j from state i
+				}
+			}
+			alpha[t][j] *= emission[j][observations[t]]
+			scales[t] += alpha[t][j]
+		}
+
+		if scales[t] == 0 {
+			return nil, nil, fmt.Errorf("sequence has zero probability under the model at position %d", t+1)
+		}
+		for j := 0; j < N; j++ {
+			alpha[t][j] /= scales[t] // Normalize so the row sums to 1
+		}
+	}
+	return alpha, scales, nil
+}
+
+// scaledBackward is the backward pass of Backward on observation indices and plain (not log) probabilities.
+func (hmm *HMM) scaledBackward(observations []int, scales []float64, transition, emission [][]float64) [][]float64 {
+	T := len(observations) // Length of the observation sequence
+	N := len(hmm.States)   // Number of states in the HMM
+
+	beta := make([][]float64, T)
+	for t := T - 1; t >= 0; t-- {
+		beta[t] = make([]float64, N)
+		for i := 0; i < N; i++ {
+			if t == T-1 {
+				beta[t][i] = 1.0 // Nothing left to observe
+				continue
+			}
+			for j := 0; j < N; j++ {
+				beta[t][i] += transition[i][j] * emission[j][observations[t+1]] * beta[t+1][j]
+			}
+			beta[t][i] /= scales[t+1] // Apply the same scaling as the forward pass
+		}
+	}
+	return beta
+}
+
+// Posterior computes, for each position of the sequence, the probability of every state given the
+// whole sequence (forward-backward). posteriors[t] is ordered like hmm.States and sums to 1.
+// It also returns the log-likelihood of the sequence.
+func (hmm *HMM) Posterior(sequence string) (posteriors [][]float64, logLikelihood float64, err error) {
+	alpha, scales, err := hmm.Forward(sequence)
+	if err != nil {
+		return nil, 0, err
+	}
+	beta, err := hmm.Backward(sequence, scales)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	posteriors = make([][]float64, len(sequence))
+	for t := range posteriors {
+		posteriors[t] = make([]float64, len(hmm.States))
+		total := 0.0
+		for i := range hmm.States {
+			posteriors[t][i] = alpha[t][i] * beta[t][i]
+			total += posteriors[t][i]
+		}
+		for i := range hmm.States {
+			posteriors[t][i] /= total // Guard against rounding drift
+		}
+		logLikelihood += math.Log(scales[t])
+	}
+
+	return posteriors, logLikelihood, nil
+}
+
+// PosteriorDecode labels each position with the first letter of its most probable state under the
+// posterior distribution, and returns the posteriors used for the decision.
+func (hmm *HMM) PosteriorDecode(sequence string) (string, [][]float64, error) {
+	posteriors, _, err := hmm.Posterior(sequence)
+	if err != nil {
+		return "", nil, err
+	}
+
+	labels := make([]byte, len(posteriors))
+	for t, probs := range posteriors {
+		best := 0
+		for i, p := range probs {
+			if p > probs[best] {
+				best = i
+			}
+		}
+		labels[t] = hmm.States[best][0] // Use the first letter of each state
+	}
+	return string(labels), posteriors, nil
 }
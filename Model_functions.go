@@ -0,0 +1,182 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+)
+
+// HMMModelFormatVersion is the version written to new model files. Files with a newer version are rejected.
+const HMMModelFormatVersion = 1
+
+// probabilityTolerance is how far a row of probabilities may sum from 1 in a model file
+const probabilityTolerance = 1e-6
+
+// WriteHMMModel()
+// Input: a writer, the HMM and the training provenance to record
+// Output: an error if encoding fails
+// The model is written as indented JSON with probabilities (not log probabilities), so it can be read and edited by hand.
+func WriteHMMModel(w io.Writer, hmm *HMM, provenance HMMProvenance) error {
+	initial, transition, emission := hmm.Probabilities() // Convert back from log space
+	model := HMMModelFile{
+		FormatVersion: HMMModelFormatVersion,
+		States:        hmm.States,
+		Symbols:       hmm.Symbols,
+		Initial:       initial,
+		Transition:    transition,
+		Emission:      emission,
+		Provenance:    provenance,
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(model)
+}
+
+// SaveHMMModel()
+// Input: the path of the model file to create, the HMM and its training provenance
+// Output: an error if the file cannot be written
+func SaveHMMModel(filename string, hmm *HMM, provenance HMMProvenance) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create model file %s: %v", filename, err)
+	}
+	if err := WriteHMMModel(file, hmm, provenance); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write model file %s: %v", filename, err)
+	}
+	return file.Close()
+}
+
+// ReadHMMModel()
+// Input: an io.Reader with a JSON model file
+// Output: the HMM (parameters converted to log space) and the provenance recorded in the file, or an error
+// if the file has an unsupported version or inconsistent dimensions, or a row is not a probability distribution.
+func ReadHMMModel(r io.Reader) (*HMM, HMMProvenance, error) {
+	var model HMMModelFile
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&model); err != nil {
+		return nil, HMMProvenance{}, fmt.Errorf("invalid model file: %v", err)
+	}
+
+	if model.FormatVersion < 1 || model.FormatVersion > HMMModelFormatVersion {
+		return nil, HMMProvenance{}, fmt.Errorf("unsupported model format version %d (supported: 1 to %d)", model.FormatVersion, HMMModelFormatVersion)
+	}
+	if err := validateHMMModel(model); err != nil {
+		return nil, HMMProvenance{}, err
+	}
+
+	hmm := NewHMM(model.States, model.Symbols)
+	hmm.LoadProbabilities(model.Initial, model.Transition, model.Emission) // Stored as log probabilities
+	return hmm, model.Provenance, nil
+}
+
+// LoadHMMModel()
+// Input: the path of a JSON model file
+// Output: the HMM and its provenance, or an error
+func LoadHMMModel(filename string) (*HMM, HMMProvenance, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, HMMProvenance{}, fmt.Errorf("failed to open model file %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	hmm, provenance, err := ReadHMMModel(file)
+	if err != nil {
+		return nil, HMMProvenance{}, fmt.Errorf("%s: %v", filename, err)
+	}
+	return hmm, provenance, nil
+}
+
+// validateHMMModel checks that states and symbols are unique and non-empty, that the symbols include every
+// amino acid in AminoAcidSymbols, and that every probability vector has the right length and sums to 1
+func validateHMMModel(model HMMModelFile) error {
+	if err := validateNames("state", model.States); err != nil {
+		return err
+	}
+	if err := validateNames("symbol", model.Symbols); err != nil {
+		return err
+	}
+
+	numStates, numSymbols := len(model.States), len(model.Symbols)
+	if err := validateDistribution("initial", model.Initial, numStates); err != nil {
+		return err
+	}
+	if len(model.Transition) != numStates {
+		return fmt.Errorf("transition matrix has %d rows, expected %d", len(model.Transition), numStates)
+	}
+	if len(model.Emission) != numStates {
+		return fmt.Errorf("emission matrix has %d rows, expected %d", len(model.Emission), numStates)
+	}
+	for i, state := range model.States {
+		if err := validateDistribution("transition row "+state, model.Transition[i], numStates); err != nil {
+			return err
+		}
+		if err := validateDistribution("emission row "+state, model.Emission[i], numSymbols); err != nil {
+			return err
+		}
+	}
+	return validateAminoAcidSymbols(model.Symbols)
+}
+
+// validateAminoAcidSymbols reports the amino acids of AminoAcidSymbols missing from the symbols of a model, which
+// could not be decoded in any sequence containing them
+func validateAminoAcidSymbols(symbols []string) error {
+	present := make(map[string]bool)
+	for _, symbol := range symbols {
+		present[symbol] = true
+	}
+	var missing []string
+	for _, symbol := range AminoAcidSymbols {
+		if !present[symbol] {
+			missing = append(missing, symbol)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("model symbols are missing the amino acids %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// validateNames reports an empty list, an empty name or a duplicate name
+func validateNames(kind string, names []string) error {
+	if len(names) == 0 {
+		return fmt.Errorf("model has no %ss", kind)
+	}
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if name == "" {
+			return fmt.Errorf("model has an empty %s name", kind)
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicate %s %q", kind, name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// validateDistribution checks the length of a probability vector, that no entry is negative and that it sums to 1
+func validateDistribution(name string, probs []float64, expectedLen int) error {
+	if len(probs) != expectedLen {
+		return fmt.Errorf("%s has %d values, expected %d", name, len(probs), expectedLen)
+	}
+	total := 0.0
+	for _, p := range probs {
+		if p < 0 || math.IsNaN(p) {
+			return fmt.Errorf("%s contains an invalid probability %v", name, p)
+		}
+		total += p
+	}
+	if math.Abs(total-1.0) > probabilityTolerance {
+		return fmt.Errorf("%s sums to %v, expected 1", name, total)
+	}
+	return nil
+}
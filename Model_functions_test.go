@@ -0,0 +1,123 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSaveLoadHMMModel(t *testing.T) {
+	hmm := NewDefaultHMM()
+	provenance := HMMProvenance{CreatedAt: "2024-12-12T00:00:00Z", Method: "supervised", TrainingData: "train.csv", Sequences: 3}
+
+	filename := filepath.Join(t.TempDir(), "model.json")
+	if err := SaveHMMModel(filename, hmm, provenance); err != nil {
+		t.Fatalf("SaveHMMModel returned error: %v", err)
+	}
+	loaded, loadedProvenance, err := LoadHMMModel(filename)
+	if err != nil {
+		t.Fatalf("LoadHMMModel returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(loaded.States, hmm.States) || !reflect.DeepEqual(loaded.Symbols, hmm.Symbols) {
+		t.Errorf("Expected states %v and symbols %v but got %v and %v", hmm.States, hmm.Symbols, loaded.States, loaded.Symbols)
+	}
+	if loadedProvenance != provenance {
+		t.Errorf("Expected provenance %+v but got %+v", provenance, loadedProvenance)
+	}
+	for _, sequence := range []string{"MKTAYIAKQR", "GGGA", "WYPHEV"} {
+		got, err := loaded.Viterbi(sequence)
+		if err != nil {
+			t.Fatalf("Viterbi(%s) returned error: %v", sequence, err)
+		}
+		if want, _ := hmm.Viterbi(sequence); got != want {
+			t.Errorf("Viterbi(%s): expected %s from the loaded model but got %s", sequence, want, got)
+		}
+	}
+}
+
+func TestPredictHMMSymbolError(t *testing.T) {
+	// An HMM without tryptophan must report an error for a sequence containing W instead of exiting
+	p, err := NewPredictor("GOR_InfoVals", "")
+	if err != nil {
+		t.Fatalf("NewPredictor returned error: %v", err)
+	}
+	p.HMM = NewHMM([]string{"Helix", "Coil"}, AminoAcidSymbols[:len(AminoAcidSymbols)-2])
+	result := p.Predict(FASTARecord{ID: "p1", Sequence: "MKTWAY"})
+	if !strings.Contains(result.Error, "Error in HMM prediction: invalid symbol W at position 4") {
+		t.Errorf("Expected an HMM prediction error, got %q", result.Error)
+	}
+}
+
+func TestReadHMMModelErrors(t *testing.T) {
+	tests := []struct {
+		name    string // Name of the test case
+		model   string // Model file contents
+		wantErr string // Substring expected in the error
+	}{
+		{
+			name:    "Unsupported version",
+			model:   `{"format_version": 99, "states": ["H"], "symbols": ["A"], "initial": [1], "transition": [[1]], "emission": [[1]]}`,
+			wantErr: "unsupported model format version 99",
+		},
+		{
+			name:    "Row does not sum to 1",
+			model:   `{"format_version": 1, "states": ["H", "C"], "symbols": ["A"], "initial": [0.5, 0.5], "transition": [[0.5, 0.4], [0.5, 0.5]], "emission": [[1], [1]]}`,
+			wantErr: "transition row H sums to",
+		},
+		{
+			name:    "Mismatched dimensions",
+			model:   `{"format_version": 1, "states": ["H", "C"], "symbols": ["A", "G"], "initial": [0.5, 0.5], "transition": [[0.5, 0.5], [0.5, 0.5]], "emission": [[1], [1]]}`,
+			wantErr: "emission row H has 1 values, expected 2",
+		},
+		{
+			name:    "Duplicate state",
+			model:   `{"format_version": 1, "states": ["H", "H"], "symbols": ["A"], "initial": [0.5, 0.5], "transition": [[0.5, 0.5], [0.5, 0.5]], "emission": [[1], [1]]}`,
+			wantErr: "duplicate state",
+		},
+		{
+			name:    "Missing amino acids",
+			model:   `{"format_version": 1, "states": ["H"], "symbols": ["A", "C"], "initial": [1], "transition": [[1]], "emission": [[0.5, 0.5]]}`,
+			wantErr: "model symbols are missing the amino acids D, E, F",
+		},
+		{
+			name:    "Unknown field",
+			model:   `{"format_version": 1, "states": ["H"], "symbols": ["A"], "initial": [1], "transition": [[1]], "emission": [[1]], "extra": true}`,
+			wantErr: "unknown field",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ReadHMMModel(strings.NewReader(tt.model))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected an error containing %q but got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPredictWithTrainedModel(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "model.json")
+	var out bytes.Buffer
+	if err := RunCLI([]string{"train", "-out", filename}, &out); err != nil {
+		t.Fatalf("train returned error: %v", err)
+	}
+
+	predictor, err := NewPredictor("GOR_InfoVals", filename)
+	if err != nil {
+		t.Fatalf("Failed to load predictor: %v", err)
+	}
+	if !reflect.DeepEqual(predictor.HMM.States, []string{"H", "E", "C", "T"}) {
+		t.Errorf("Expected the trained states but got %v", predictor.HMM.States)
+	}
+	result := predictor.Predict(FASTARecord{Sequence: "MKTAYIAKQRQISFVKSHFSRQ"})
+	if result.Error != "" || len(result.HMM) != len(result.Sequence) {
+		t.Errorf("Unexpected prediction %+v", result)
+	}
+}
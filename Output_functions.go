@@ -0,0 +1,146 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Supported values of the output format option
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+	FormatTSV  = "tsv"
+)
+
+// WriteResults()
+// Input: a writer, the output format (text, json or tsv; csv is only written by WriteAssignments) and the prediction results
+// Output: an error if the format is unknown or writing fails
+func WriteResults(w io.Writer, format string, results []PredictionResult) error {
+	switch format {
+	case FormatText:
+		return WriteTextResults(w, results)
+	case FormatJSON:
+		return WriteJSONResults(w, results)
+	case FormatTSV:
+		return WriteTSVResults(w, results)
+	default:
+		return fmt.Errorf("unknown output format %q (expected %s, %s or %s)", format, FormatText, FormatJSON, FormatTSV)
+	}
+}
+
+// WriteTextResults()
+// Input: a writer and the prediction results
+// Output: an error if writing fails
+// Prints the human-readable "Predicted ... secondary structure:" lines, preceded by a ">ID" line for FASTA records.
+func WriteTextResults(w io.Writer, results []PredictionResult) error {
+	var b strings.Builder
+	for _, result := range results {
+		// Key the output of FASTA input by the record identifier
+		if result.ID != "" {
+			fmt.Fprintf(&b, ">%s\n", result.ID)
+		}
+		if result.Error != "" {
+			fmt.Fprintln(&b, result.Error)
+			continue
+		}
+
+		// Print results of predictions from different methods
+		fmt.Fprintf(&b, "Input sequence: %s\n", result.Sequence)
+		fmt.Fprintf(&b, "Predicted Chou-Fasman secondary structure: %s\n", result.ChouFasman)
+		fmt.Fprintf(&b, "Predicted GOR secondary structure: %s\n", result.GOR)
+		fmt.Fprintf(&b, "Predicted HMM secondary structure: %s\n", result.HMM) // H for Helix etc.
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteJSONResults()
+// Input: a writer and the prediction results
+// Output: an error if encoding fails
+// Writes a single indented JSON array with one object per record.
+func WriteJSONResults(w io.Writer, results []PredictionResult) error {
+	if results == nil {
+		results = []PredictionResult{} // Encode as [] rather than null
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(results)
+}
+
+// tsvHeader lists the columns written by WriteTSVResults
+var tsvHeader = []string{"id", "error", "position", "residue", "chou_fasman", "gor", "hmm", "score_alpha", "score_beta", "score_turn", "score_coil",
+	"prob_alpha", "prob_beta", "prob_turn", "prob_coil", "gor_reliability"}
+
+// WriteTSVResults()
+// Input: a writer and the prediction results
+// Output: an error if writing fails
+// Writes one tab-separated row per residue with the label of each method, the GOR scores, class probabilities
+// and reliability index, and the posterior probability of each HMM state.
+// Records with an error have no residues to report and get a single row with the id and error columns filled in.
+func WriteTSVResults(w io.Writer, results []PredictionResult) error {
+	// One posterior column per HMM state, named after the states of the first record that has them
+	var states []string
+	for _, result := range results {
+		if len(result.HMMStates) > 0 {
+			states = result.HMMStates
+			break
+		}
+	}
+	header := append([]string{}, tsvHeader...)
+	for _, state := range states {
+		header = append(header, "hmm_p_"+strings.ToLower(state))
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.Join(header, "\t") + "\n")
+	for _, result := range results {
+		if result.Error != "" {
+			row := make([]string, len(header))
+			row[0], row[1] = result.ID, result.Error
+			b.WriteString(strings.Join(row, "\t") + "\n")
+			continue
+		}
+		for i, score := range result.GORScores {
+			row := []string{
+				result.ID,
+				"", // No error
+				strconv.Itoa(score.Position),
+				score.Residue,
+				string(result.ChouFasman[i]),
+				score.PredictedStructure,
+				string(result.HMM[i]),
+				formatScore(score.ScoreAlpha),
+				formatScore(score.ScoreBeta),
+				formatScore(score.ScoreTurn),
+				formatScore(score.ScoreCoil),
+				formatScore(score.ProbAlpha),
+				formatScore(score.ProbBeta),
+				formatScore(score.ProbTurn),
+				formatScore(score.ProbCoil),
+				strconv.Itoa(score.Reliability),
+			}
+			for s := range states {
+				if i < len(result.HMMPosteriors) && s < len(result.HMMPosteriors[i]) {
+					row = append(row, formatScore(result.HMMPosteriors[i][s]))
+				} else {
+					row = append(row, "")
+				}
+			}
+			b.WriteString(strings.Join(row, "\t") + "\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// formatScore formats a score with the shortest representation that round-trips
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'g', -1, 64)
+}
@@ -0,0 +1,107 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// sampleResults returns one predicted record and one record that failed validation
+func sampleResults() []PredictionResult {
+	return []PredictionResult{
+		{
+			ID:         "P1",
+			Sequence:   "AG",
+			ChouFasman: "HC",
+			GOR:        "HT",
+			HMM:        "HH",
+			GORScores: []GORPredictionResult{
+				{Position: 1, Residue: "A", ScoreAlpha: 10, ScoreBeta: -2.5, ScoreTurn: 0, ScoreCoil: 1, PredictedStructure: "H",
+					ProbAlpha: 0.75, ProbBeta: 0.125, ProbTurn: 0.0625, ProbCoil: 0.0625, Reliability: 6},
+				{Position: 2, Residue: "G", ScoreAlpha: -1, ScoreBeta: 0, ScoreTurn: 7, ScoreCoil: 3, PredictedStructure: "T",
+					ProbAlpha: 0.125, ProbBeta: 0.125, ProbTurn: 0.5, ProbCoil: 0.25, Reliability: 2},
+			},
+		},
+		{ID: "P2", Sequence: "XZ", Error: "Invalid sequence"},
+	}
+}
+
+func TestWriteTextResults(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteTextResults(&buf, sampleResults()); err != nil {
+		t.Fatalf("WriteTextResults returned error: %v", err)
+	}
+	expected := ">P1\n" +
+		"Input sequence: AG\n" +
+		"Predicted Chou-Fasman secondary structure: HC\n" +
+		"Predicted GOR secondary structure: HT\n" +
+		"Predicted HMM secondary structure: HH\n" +
+		">P2\n" +
+		"Invalid sequence\n"
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, buf.String())
+	}
+}
+
+func TestWriteJSONResults(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJSONResults(&buf, sampleResults()); err != nil {
+		t.Fatalf("WriteJSONResults returned error: %v", err)
+	}
+
+	// The output must decode back into the same results
+	var decoded []PredictionResult
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, sampleResults()) {
+		t.Errorf("Expected %v but got %v", sampleResults(), decoded)
+	}
+}
+
+func TestWriteTSVResults(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteTSVResults(&buf, sampleResults()); err != nil {
+		t.Fatalf("WriteTSVResults returned error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	expected := []string{
+		"id\terror\tposition\tresidue\tchou_fasman\tgor\thmm\tscore_alpha\tscore_beta\tscore_turn\tscore_coil\tprob_alpha\tprob_beta\tprob_turn\tprob_coil\tgor_reliability",
+		"P1\t\t1\tA\tH\tH\tH\t10\t-2.5\t0\t1\t0.75\t0.125\t0.0625\t0.0625\t6",
+		"P1\t\t2\tG\tC\tT\tH\t-1\t0\t7\t3\t0.125\t0.125\t0.5\t0.25\t2",
+		"P2\tInvalid sequence" + strings.Repeat("\t", 14),
+	}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("Expected %q but got %q", expected, lines)
+	}
+}
+
+func TestWriteResultsUnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteResults(&buf, "xml", sampleResults()); err == nil {
+		t.Errorf("Expected an error for an unknown format")
+	}
+}
+
+func TestWriteTSVResultsPosteriors(t *testing.T) {
+	results := sampleResults()
+	results[0].HMMStates = []string{"Helix", "Coil"}
+	results[0].HMMPosteriors = [][]float64{{0.75, 0.25}, {0.5, 0.5}}
+
+	var buf bytes.Buffer
+	if err := WriteTSVResults(&buf, results); err != nil {
+		t.Fatalf("WriteTSVResults returned error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if !strings.HasSuffix(lines[0], "\thmm_p_helix\thmm_p_coil") {
+		t.Errorf("Header is missing the posterior columns: %q", lines[0])
+	}
+	if !strings.HasSuffix(lines[1], "\t0.75\t0.25") || !strings.HasSuffix(lines[2], "\t0.5\t0.5") {
+		t.Errorf("Rows are missing the posteriors: %q", lines[1:])
+	}
+}
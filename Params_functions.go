@@ -0,0 +1,230 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"embed"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// embeddedGORTables holds the default GOR information tables, so the binary works from any directory
+//
+//go:embed GOR_InfoVals/*.csv
+var embeddedGORTables embed.FS
+
+// embeddedGORDir is the directory of the default GOR tables inside embeddedGORTables
+const embeddedGORDir = "GOR_InfoVals"
+
+// cfParameterHeader is the header of a Chou-Fasman parameter file
+var cfParameterHeader = []string{"Residue", "Helix", "Sheet", "Turn", "Bend1", "Bend2", "Bend3", "Bend4"}
+
+// readGORTable()
+// Input: a directory of GOR tables ("" for the built-in tables) and the file name of one table
+// Output: the parsed table, or an error naming the file
+func readGORTable(gorDir, name string) (InfoValTable, error) {
+	if gorDir != "" {
+		return ReadGORParameters(filepath.Join(gorDir, name))
+	}
+	file, err := embeddedGORTables.Open(path.Join(embeddedGORDir, name))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	params, err := ParseGORParameters(file)
+	if err != nil {
+		return nil, fmt.Errorf("built-in %s: %v", name, err)
+	}
+	return params, nil
+}
+
+// ParseCFParameters()
+// Input: an io.Reader with a CSV header Residue,Helix,Sheet,Turn,Bend1,Bend2,Bend3,Bend4 and one row per amino acid
+// Output: the Chou-Fasman propensities (Pa, Pb, Pt) and the bend probabilities of the four tetrapeptide positions
+// (f(i)..f(i+3)), or an error if the header or a row is malformed or one of the 20 standard amino acids is missing
+func ParseCFParameters(r io.Reader) (map[rune]AminoAcidPropensities, map[rune]BendProbabilities, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1 // Row lengths are checked below with a clearer message
+	reader.Comment = '#'
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read header: %v", err)
+	}
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	if strings.Join(header, ",") != strings.Join(cfParameterHeader, ",") {
+		return nil, nil, fmt.Errorf("header must be %s, got %s", strings.Join(cfParameterHeader, ","), strings.Join(header, ","))
+	}
+
+	props := make(map[rune]AminoAcidPropensities)
+	bends := make(map[rune]BendProbabilities)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break // End of file reached
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read record: %v", err)
+		}
+		if len(record) != len(cfParameterHeader) {
+			return nil, nil, fmt.Errorf("row %s has %d values, expected %d", record[0], len(record)-1, len(cfParameterHeader)-1)
+		}
+
+		aa := strings.ToUpper(record[0])
+		if len(aa) != 1 || !strings.Contains(strings.Join(AminoAcidSymbols, ""), aa) {
+			return nil, nil, fmt.Errorf("unknown residue %q", record[0])
+		}
+		if _, seen := props[rune(aa[0])]; seen {
+			return nil, nil, fmt.Errorf("duplicate row for %s", aa)
+		}
+		values := make([]float64, len(record)-1)
+		for k, field := range record[1:] {
+			value, err := strconv.ParseFloat(field, 64)
+			if err != nil || value < 0 {
+				return nil, nil, fmt.Errorf("row %s: invalid %s value %q", aa, cfParameterHeader[k+1], field)
+			}
+			values[k] = value
+		}
+		props[rune(aa[0])] = AminoAcidPropensities{alphaHelix: values[0], betaSheet: values[1], turn: values[2]}
+		bends[rune(aa[0])] = BendProbabilities{p1: values[3], p2: values[4], p3: values[5], p4: values[6]}
+	}
+
+	var missing []string
+	for _, aa := range AminoAcidSymbols {
+		if _, ok := props[rune(aa[0])]; !ok {
+			missing = append(missing, aa)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, nil, fmt.Errorf("missing rows for %s", strings.Join(missing, ", "))
+	}
+	return props, bends, nil
+}
+
+// DefaultCFParameters returns the Chou-Fasman tables built into the program (propensities and bendProbabilitiesTable)
+func DefaultCFParameters() CFParameters {
+	return CFParameters{Propensities: propensities, Bends: bendProbabilitiesTable}
+}
+
+// LoadCFParameters()
+// Input: the path of a Chou-Fasman parameter file (see ParseCFParameters)
+// Output: an error if the file cannot be read; on success Predict uses its values instead of the built-in
+// Chou-Fasman tables
+func (p *Predictor) LoadCFParameters(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return fmt.Errorf("failed to open file %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	props, bends, err := ParseCFParameters(file)
+	if err != nil {
+		return fmt.Errorf("%s: %v", filename, err)
+	}
+	p.CF = CFParameters{Propensities: props, Bends: bends}
+	return nil
+}
+
+// WriteCFParameters()
+// Input: a writer, the propensities and the bend probabilities
+// Output: an error if writing fails
+// Writes the layout read by ParseCFParameters, one row per standard amino acid.
+func WriteCFParameters(w io.Writer, props map[rune]AminoAcidPropensities, bends map[rune]BendProbabilities) error {
+	writer := csv.NewWriter(w)
+	writer.Write(cfParameterHeader)
+	for _, aa := range AminoAcidSymbols {
+		p, b := props[rune(aa[0])], bends[rune(aa[0])]
+		row := []string{aa}
+		for _, value := range []float64{p.alphaHelix, p.betaSheet, p.turn, b.p1, b.p2, b.p3, b.p4} {
+			row = append(row, strconv.FormatFloat(value, 'f', -1, 64))
+		}
+		writer.Write(row)
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// ReadParameterConfig()
+// Input: the path of a JSON configuration file with the optional keys gor_dir, gor3_dir, gor_constants,
+// gor_temperature, gor_edge, hmm_model, cf_params, cf_set and cf_overlap
+// Output: the configuration with relative paths resolved against the directory of the file, or an error if the
+// file cannot be read or has unknown keys
+func ReadParameterConfig(filename string) (ParameterConfig, error) {
+	var config ParameterConfig
+	file, err := os.Open(filename)
+	if err != nil {
+		return config, fmt.Errorf("failed to open config file %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	decoder := json.NewDecoder(file)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return config, fmt.Errorf("%s: %v", filename, err)
+	}
+
+	// Paths in the file are relative to the file itself, so a config can travel with its parameter files
+	dir := filepath.Dir(filename)
+	for _, value := range []*string{&config.GORDir, &config.GOR3Dir, &config.HMMModel, &config.CFParams, &config.GORConstants} {
+		if *value != "" && !filepath.IsAbs(*value) {
+			*value = filepath.Join(dir, *value)
+		}
+	}
+	return config, nil
+}
+
+// ExportDefaultParameters()
+// Input: a directory to write to and the Chou-Fasman parameters to write to cf_params.csv
+// Output: an error if a file cannot be written
+// Writes the built-in GOR tables (GOR_InfoVals/), Chou-Fasman parameters (cf_params.csv), HMM (hmm_model.json)
+// and a config.json naming them, as a starting point for parameter files passed with -config.
+func ExportDefaultParameters(dir string, cf CFParameters) error {
+	gorDir := filepath.Join(dir, embeddedGORDir)
+	if err := os.MkdirAll(gorDir, 0o755); err != nil {
+		return err
+	}
+	for _, name := range gorFiles {
+		content, err := embeddedGORTables.ReadFile(path.Join(embeddedGORDir, name))
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(gorDir, name), content, 0o644); err != nil {
+			return err
+		}
+	}
+
+	cfFile, err := os.Create(filepath.Join(dir, "cf_params.csv"))
+	if err != nil {
+		return err
+	}
+	if err := WriteCFParameters(cfFile, cf.Propensities, cf.Bends); err != nil {
+		cfFile.Close()
+		return err
+	}
+	if err := cfFile.Close(); err != nil {
+		return err
+	}
+
+	provenance := HMMProvenance{Method: "built-in", Notes: "default HMM parameters of NewDefaultHMM"}
+	if err := SaveHMMModel(filepath.Join(dir, "hmm_model.json"), NewDefaultHMM(), provenance); err != nil {
+		return err
+	}
+
+	config := ParameterConfig{GORDir: embeddedGORDir, HMMModel: "hmm_model.json", CFParams: "cf_params.csv"}
+	content, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "config.json"), append(content, '\n'), 0o644)
+}
@@ -0,0 +1,121 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewPredictorBuiltInTables(t *testing.T) {
+	builtIn, err := NewPredictor("", "")
+	if err != nil {
+		t.Fatalf("NewPredictor returned error: %v", err)
+	}
+	fromFiles, err := NewPredictor("GOR_InfoVals", "")
+	if err != nil {
+		t.Fatalf("NewPredictor returned error: %v", err)
+	}
+	if !reflect.DeepEqual(builtIn.AlphaParams, fromFiles.AlphaParams) || !reflect.DeepEqual(builtIn.CoilParams, fromFiles.CoilParams) {
+		t.Errorf("Built-in GOR tables differ from GOR_InfoVals")
+	}
+}
+
+func TestParseCFParameters(t *testing.T) {
+	var b bytes.Buffer
+	if err := WriteCFParameters(&b, propensities, bendProbabilitiesTable); err != nil {
+		t.Fatalf("WriteCFParameters returned error: %v", err)
+	}
+	content := b.String()
+
+	props, bends, err := ParseCFParameters(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("ParseCFParameters returned error: %v", err)
+	}
+	if !reflect.DeepEqual(props, propensities) || !reflect.DeepEqual(bends, bendProbabilitiesTable) {
+		t.Errorf("Round trip changed the Chou-Fasman parameters")
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		message string
+	}{
+		{"bad header", strings.Replace(content, "Helix", "Alpha", 1), "header must be Residue,Helix,Sheet,Turn,Bend1,Bend2,Bend3,Bend4"},
+		{"short row", strings.Replace(content, "\nA,1.42,", "\nA,", 1), "row A has 6 values, expected 7"},
+		{"unknown residue", content + "B,1,1,1,0.1,0.1,0.1,0.1\n", `unknown residue "B"`},
+		{"duplicate", content + "A,1,1,1,0.1,0.1,0.1,0.1\n", "duplicate row for A"},
+		{"negative", strings.Replace(content, "\nA,1.42,", "\nA,-1.42,", 1), `row A: invalid Helix value "-1.42"`},
+		{"missing", strings.SplitN(content, "\nV,", 2)[0] + "\n", "missing rows for V"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := ParseCFParameters(strings.NewReader(test.content))
+			if err == nil || !strings.Contains(err.Error(), test.message) {
+				t.Errorf("Expected error containing %q, got %v", test.message, err)
+			}
+		})
+	}
+}
+
+func TestParameterConfig(t *testing.T) {
+	dir := t.TempDir()
+	var out bytes.Buffer
+	if err := RunCLI([]string{"params", "-out", dir}, &out); err != nil {
+		t.Fatalf("params returned error: %v", err)
+	}
+	config := filepath.Join(dir, "config.json")
+	sequence := "MKTAYIAKQRQISFVKSHFSRQLEERLGLIEVQ"
+
+	// The exported parameters reproduce the built-in predictions
+	var builtIn, exported bytes.Buffer
+	if err := RunCLI([]string{"predict", sequence}, &builtIn); err != nil {
+		t.Fatal(err)
+	}
+	if err := RunCLI([]string{"predict", "-config", config, sequence}, &exported); err != nil {
+		t.Fatalf("predict -config returned error: %v", err)
+	}
+	if builtIn.String() != exported.String() {
+		t.Errorf("Exported parameters changed the predictions:\n%s\n%s", builtIn.String(), exported.String())
+	}
+
+	// An edited Chou-Fasman file changes the Chou-Fasman prediction
+	var cf strings.Builder
+	cf.WriteString("Residue,Helix,Sheet,Turn,Bend1,Bend2,Bend3,Bend4\n")
+	for _, aa := range AminoAcidSymbols {
+		cf.WriteString(aa + ",0.5,2,0.5,0.01,0.01,0.01,0.01\n")
+	}
+	if err := os.WriteFile(filepath.Join(dir, "cf_params.csv"), []byte(cf.String()), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+	if err := RunCLI([]string{"predict", "-config", config, sequence}, &out); err != nil {
+		t.Fatalf("predict -config returned error: %v", err)
+	}
+	if !strings.Contains(out.String(), "Predicted Chou-Fasman secondary structure: "+strings.Repeat("E", len(sequence))) {
+		t.Errorf("Expected an all-strand Chou-Fasman prediction, got:\n%s", out.String())
+	}
+
+	// Flags take precedence over the config file
+	if err := os.WriteFile(config, []byte(`{"gor_dir": "missing"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := RunCLI([]string{"predict", "-config", config, sequence}, &out); err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Errorf("Expected an error for the missing GOR directory, got %v", err)
+	}
+	if err := RunCLI([]string{"predict", "-config", config, "-gor-dir", "", sequence}, &out); err != nil {
+		t.Errorf("Expected -gor-dir to override the config file, got %v", err)
+	}
+
+	if err := os.WriteFile(config, []byte(`{"gor_directory": "x"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := RunCLI([]string{"predict", "-config", config, sequence}, &out); err == nil || !strings.Contains(err.Error(), "unknown field") {
+		t.Errorf("Expected an unknown field error, got %v", err)
+	}
+}
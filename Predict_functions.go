@@ -0,0 +1,220 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// NewPredictor()
+// Input: the directory holding the four GOR information value tables (InfoVal_*.csv; "" selects the tables
+// built into the binary), and the path of an HMM model file ("" selects the built-in HMM)
+// Output: a Predictor with the GOR tables and HMM loaded, or an error if a file cannot be read
+func NewPredictor(gorDir, hmmModelFile string) (*Predictor, error) {
+	alphaParams, err := readGORTable(gorDir, "InfoVal_aHelix.csv")
+	if err != nil {
+		return nil, fmt.Errorf("error reading alpha parameters: %v", err)
+	}
+
+	betaParams, err := readGORTable(gorDir, "InfoVal_bStrand.csv")
+	if err != nil {
+		return nil, fmt.Errorf("error reading beta parameters: %v", err)
+	}
+
+	turnParams, err := readGORTable(gorDir, "InfoVal_bTurn.csv")
+	if err != nil {
+		return nil, fmt.Errorf("error reading turn parameters: %v", err)
+	}
+
+	coilParams, err := readGORTable(gorDir, "InfoVal_Coil.csv")
+	if err != nil {
+		return nil, fmt.Errorf("error reading coil parameters: %v", err)
+	}
+
+	if err := ValidateGORTables(alphaParams, betaParams, turnParams, coilParams); err != nil {
+		if gorDir == "" {
+			gorDir = "built-in tables"
+		}
+		return nil, fmt.Errorf("invalid GOR parameters in %s: %v", gorDir, err)
+	}
+
+	hmm := NewDefaultHMM()
+	if hmmModelFile != "" {
+		hmm, _, err = LoadHMMModel(hmmModelFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading HMM model: %v", err)
+		}
+	}
+
+	return &Predictor{
+		AlphaParams: alphaParams,
+		BetaParams:  betaParams,
+		TurnParams:  turnParams,
+		CoilParams:  coilParams,
+		HMM:         hmm,
+	}, nil
+}
+
+// Predict()
+// Input: a FASTARecord (the ID may be empty for a raw sequence)
+// Output: a PredictionResult holding the CF, GOR and HMM predictions.
+// Records that cannot be predicted are returned with the Error field set instead.
+func (p *Predictor) Predict(record FASTARecord) PredictionResult {
+	return p.predict(record, nil)
+}
+
+// PredictAlignment()
+// Input: the rows of a multiple sequence alignment, the first of which is the query
+// Output: a PredictionResult for the query, whose GOR prediction averages the GOR scores of every row (see
+// GORAlignmentPredict); Chou-Fasman and HMM predict from the query alone
+func (p *Predictor) PredictAlignment(alignment []FASTARecord) PredictionResult {
+	if len(alignment) == 0 {
+		return PredictionResult{Error: "Empty alignment."}
+	}
+	query := alignment[0]
+	query.Sequence = ungapped(query.Sequence)
+	return p.predict(query, alignment)
+}
+
+// predict runs the three methods on a record; when alignment is not nil, GOR averages over its rows
+func (p *Predictor) predict(record FASTARecord, alignment []FASTARecord) PredictionResult {
+	// Convert input sequence to uppercase
+	sequence := strings.ToUpper(record.Sequence)
+	result := PredictionResult{
+		ID:          record.ID,
+		Description: record.Description,
+		Sequence:    sequence,
+	}
+
+	if sequence == "" {
+		result.Error = "No sequence provided."
+		return result
+	}
+
+	// Validate the input sequence for valid amino acids
+	if !isValidSequence(sequence) {
+		result.Error = "Invalid sequence: sequence must only contain valid amino acid codes (A,R,N,D,C,Q,E,G,H,I,L,K,M,F,P,S,T,W,Y,V)"
+		return result
+	}
+
+	// Predict the secondary structure using GOR method, averaged over the alignment rows if one is given
+	var gorPredictions []GORPredictionResult
+	var err error
+	if alignment != nil {
+		gorPredictions, err = GORAlignmentPredict(alignment, p.predictGOR)
+		result.AlignmentRows = len(alignment)
+	} else {
+		gorPredictions, err = p.predictGOR(sequence)
+	}
+	if err != nil {
+		result.Error = fmt.Sprintf("Error in GOR prediction: %v", err)
+		return result
+	}
+	ApplyGORDecisionConstants(gorPredictions, p.GORConstants)
+	SetGORProbabilities(gorPredictions, p.GORTemperature)
+
+	cf := p.CF
+	if cf.Propensities == nil {
+		cf = DefaultCFParameters()
+	}
+	result.ChouFasman = cf.ChouFasmanPredictSS([]rune(sequence), p.CFOverlap) // CF works on runes
+	result.GOR = OutputGORSequence(gorPredictions)
+	result.GORScores = gorPredictions
+	result.HMM, err = p.HMM.Viterbi(sequence) // Predict using Viterbi algorithm
+	if err != nil {
+		result.Error = fmt.Sprintf("Error in HMM prediction: %v", err)
+		return result
+	}
+
+	// Per-residue state probabilities from forward-backward
+	posteriors, _, err := p.HMM.Posterior(sequence)
+	if err != nil {
+		result.Error = fmt.Sprintf("Error in HMM posterior decoding: %v", err)
+		return result
+	}
+	result.HMMStates = p.HMM.States
+	result.HMMPosteriors = posteriors
+
+	return result
+}
+
+// predictGOR scores a sequence with GOR, with pair information (GOR III) if it is loaded, and the edge mode of p
+func (p *Predictor) predictGOR(sequence string) ([]GORPredictionResult, error) {
+	edge, err := ParseGOREdgeMode(p.GOREdge)
+	if err != nil {
+		return nil, err
+	}
+	if p.AlphaPairParams != nil {
+		return GORPairPredictEdge(sequence, edge, p.AlphaPairParams, p.BetaPairParams, p.TurnPairParams, p.CoilPairParams)
+	}
+	return GORPredictEdge(sequence, edge, p.AlphaParams, p.BetaParams, p.TurnParams, p.CoilParams)
+}
+
+// ReadInputRecords()
+// Input: the command-line argument naming the input
+// Output: the records to predict. "-" reads FASTA from stdin, an existing file is read as FASTA,
+// and anything else is treated as a single raw sequence with an empty identifier.
+func ReadInputRecords(arg string) ([]FASTARecord, error) {
+	if arg == "-" {
+		return ReadFASTAFile(arg)
+	}
+	if info, err := os.Stat(arg); err == nil && !info.IsDir() {
+		return ReadFASTAFile(arg)
+	}
+	return []FASTARecord{{Sequence: arg}}, nil
+}
+
+// NewDefaultHMM()
+// Input: none
+// Output: the HMM with the states, symbols and parameters used for prediction
+func NewDefaultHMM() *HMM {
+	// Define states for HMM; the symbols are the 20 standard amino acids
+	states := []string{"Helix", "ESheet", "Coil", "Turn"}
+
+	hmm := NewHMM(states, AminoAcidSymbols) // Create the HMM object
+
+	initial := []float64{0.29, 0.29, 0.33, 0.09} // Initial probabilities for each state
+
+	transition := [][]float64{ // Transition probabilities between states
+		{0.4, 0.3, 0.2, 0.1},     // Helix transitions
+		{0.3, 0.39, 0.21, 0.1},   // Sheet transitions
+		{0.25, 0.2, 0.4, 0.15},   // Coil transitions
+		{0.22, 0.22, 0.22, 0.34}, // Turn transitions
+	}
+
+	emission := [][]float64{ // Emission probabilities for each state and symbol
+		// Helix emissions
+		{0.09600544711756695, 0.010667271901951884, 0.04312301407172038, 0.08556513844757149,
+			0.04153427144802542, 0.03313663186563777, 0.01702224239673173, 0.06672719019518839,
+			0.06967771221062188, 0.12210621879255561, 0.02973218338629142, 0.028370403994552883,
+			0.02292328642759873, 0.060372219700408535, 0.056513844757149344, 0.061053109396277803,
+			0.04312301407172038, 0.06740807989105765, 0.01702224239673173, 0.027916477530640037},
+		// Sheet emissions
+		{0.061148086522462564, 0.018718801996672214, 0.024126455906821963, 0.04492512479201331,
+			0.05698835274542429, 0.04159733777038269, 0.02454242928452579, 0.08527454242928452,
+			0.04076539101497504, 0.11314475873544093, 0.0262063227953411, 0.022462562396006656,
+			0.014143094841930116, 0.03410981697171381, 0.042429284525790346, 0.044509151414309486,
+			0.08153078202995008, 0.14101497504159735, 0.025374376039933443, 0.05698835274542429},
+		// Coil emissions
+		{0.06551990722844994, 0.014302280633938926, 0.08233475067645922, 0.054310011596443754,
+			0.029764205643602628, 0.08909934286818709, 0.03710862002319289, 0.031310398144569,
+			0.04580595284112872, 0.06378044066486277, 0.020487050637804406, 0.057209122535755705,
+			0.08658678005411674, 0.03691534596057209, 0.040780827212988015, 0.09161190568225744,
+			0.06358716660224198, 0.049671434093544645, 0.01256281407035176, 0.027251642829532276},
+
+		// Turn emissions
+		{0.054404145077720206, 0.007772020725388601, 0.10233160621761658, 0.06347150259067358,
+			0.012953367875647668, 0.20725388601036268, 0.019430051813471502, 0.006476683937823834,
+			0.05569948186528497, 0.03238341968911917, 0.011658031088082901, 0.10880829015544041,
+			0.09844559585492228, 0.04404145077720207, 0.05181347150259067, 0.06347150259067358,
+			0.031088082901554404, 0.015544041450777202, 0.0025906735751295338, 0.010362694300518135},
+	}
+
+	hmm.LoadProbabilities(initial, transition, emission) // Stored as log probabilities
+
+	return hmm
+}
@@ -0,0 +1,134 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultReductionScheme is the scheme used when none is chosen. It leaves H/E/T/C labels unchanged.
+const DefaultReductionScheme = "hetc"
+
+// ReductionSchemes lists the built-in ways of reducing the eight DSSP states
+var ReductionSchemes = []ReductionScheme{
+	{
+		Name:        "hetc",
+		Description: "HGI->H, EB->E, T->T, rest->C (four states, as in AccuracyTestDataset_50.csv)",
+		Classes:     []string{"H", "E", "C", "T"},
+		Mapping:     map[byte]byte{'H': 'H', 'G': 'H', 'I': 'H', 'E': 'E', 'B': 'E', 'T': 'T'},
+		Default:     'C',
+	},
+	{
+		Name:        "hec",
+		Description: "HGI->H, EB->E, rest->C (three states, common in published benchmarks)",
+		Classes:     []string{"H", "E", "C"},
+		Mapping:     map[byte]byte{'H': 'H', 'G': 'H', 'I': 'H', 'E': 'E', 'B': 'E'},
+		Default:     'C',
+	},
+	{
+		Name:        "hec-strict",
+		Description: "H->H, E->E, rest->C (three states, only alpha helices and extended strands)",
+		Classes:     []string{"H", "E", "C"},
+		Mapping:     map[byte]byte{'H': 'H', 'E': 'E'},
+		Default:     'C',
+	},
+	{
+		Name:        "hetc-strict",
+		Description: "H->H, E->E, T->T, rest->C (four states, only alpha helices and extended strands)",
+		Classes:     []string{"H", "E", "C", "T"},
+		Mapping:     map[byte]byte{'H': 'H', 'E': 'E', 'T': 'T'},
+		Default:     'C',
+	},
+}
+
+// ParseReductionScheme()
+// Input: the name of a built-in scheme, or a custom mapping such as "HGI:H,EB:E,T:T,*:C" that lists the
+// DSSP codes of each class ("*" names the class of every other code, C if omitted)
+// Output: the ReductionScheme, or an error for an unknown name, a malformed mapping or a class outside
+// StructureClasses (the only classes that are scored and have GOR tables)
+func ParseReductionScheme(spec string) (ReductionScheme, error) {
+	for _, scheme := range ReductionSchemes {
+		if scheme.Name == spec {
+			return scheme, nil
+		}
+	}
+	if !strings.Contains(spec, ":") {
+		names := make([]string, len(ReductionSchemes))
+		for i, scheme := range ReductionSchemes {
+			names[i] = scheme.Name
+		}
+		return ReductionScheme{}, fmt.Errorf("unknown reduction scheme %q (expected %s, or a mapping like HGI:H,EB:E,*:C)", spec, strings.Join(names, ", "))
+	}
+
+	scheme := ReductionScheme{Name: spec, Description: "custom mapping " + spec, Mapping: make(map[byte]byte), Default: 'C'}
+	addClass := func(class byte) {
+		for _, existing := range scheme.Classes {
+			if existing == string(class) {
+				return
+			}
+		}
+		scheme.Classes = append(scheme.Classes, string(class))
+	}
+	for _, part := range strings.Split(spec, ",") {
+		codes, class, ok := strings.Cut(strings.TrimSpace(part), ":")
+		if !ok || codes == "" || len(class) != 1 {
+			return ReductionScheme{}, fmt.Errorf("invalid reduction rule %q: expected <codes>:<class>", part)
+		}
+		if !isStructureClass(class) {
+			return ReductionScheme{}, fmt.Errorf("invalid reduction rule %q: class %s is not one of %s", part, class, strings.Join(StructureClasses, ", "))
+		}
+		if codes == "*" {
+			scheme.Default = class[0]
+			continue
+		}
+		for i := 0; i < len(codes); i++ {
+			if _, seen := scheme.Mapping[codes[i]]; seen {
+				return ReductionScheme{}, fmt.Errorf("DSSP code %c is mapped twice in %q", codes[i], spec)
+			}
+			scheme.Mapping[codes[i]] = class[0]
+		}
+		addClass(class[0])
+	}
+	addClass(scheme.Default)
+	return scheme, nil
+}
+
+// isStructureClass reports whether class is one of StructureClasses
+func isStructureClass(class string) bool {
+	for _, known := range StructureClasses {
+		if class == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Reduce()
+// Input: a DSSP label string
+// Output: the label string with every code replaced by its class
+func (s ReductionScheme) Reduce(labels string) string {
+	reduced := []byte(labels)
+	for i, code := range reduced {
+		if class, ok := s.Mapping[code]; ok {
+			reduced[i] = class
+		} else {
+			reduced[i] = s.Default
+		}
+	}
+	return string(reduced)
+}
+
+// ReduceDataset()
+// Input: labeled proteins and the reduction scheme to apply
+// Output: a copy of the proteins with every label string reduced
+func ReduceDataset(proteins []LabeledProtein, scheme ReductionScheme) []LabeledProtein {
+	reduced := make([]LabeledProtein, len(proteins))
+	for i, protein := range proteins {
+		reduced[i] = protein
+		reduced[i].Labels = scheme.Reduce(protein.Labels)
+	}
+	return reduced
+}
@@ -0,0 +1,74 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReductionSchemes(t *testing.T) {
+	dssp := "HGIEBTSP-C"
+	tests := []struct {
+		scheme   string // Name of the scheme
+		expected string // Reduced labels
+	}{
+		{scheme: "hetc", expected: "HHHEETCCCC"},
+		{scheme: "hec", expected: "HHHEECCCCC"},
+		{scheme: "hec-strict", expected: "HCCECCCCCC"},
+		{scheme: "hetc-strict", expected: "HCCECTCCCC"},
+		{scheme: "HGI:H,EB:E,TS:T", expected: "HHHEETTCCC"},
+		{scheme: "H:H,E:E,*:T", expected: "HTTETTTTTT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scheme, func(t *testing.T) {
+			scheme, err := ParseReductionScheme(tt.scheme)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result := scheme.Reduce(dssp); result != tt.expected {
+				t.Errorf("Expected %s but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestReductionSchemeDatasetUnchanged(t *testing.T) {
+	// The default scheme must leave H/E/T/C ground truth as it is
+	scheme, err := ParseReductionScheme(DefaultReductionScheme)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result := scheme.Reduce("HHEETTCC"); result != "HHEETTCC" {
+		t.Errorf("Expected HHEETTCC but got %s", result)
+	}
+}
+
+func TestParseReductionSchemeCustomClasses(t *testing.T) {
+	scheme, err := ParseReductionScheme("HGI:H,TS:T,EB:E")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(scheme.Classes, []string{"H", "T", "E", "C"}) {
+		t.Errorf("Expected classes [H T E C] but got %v", scheme.Classes)
+	}
+}
+
+func TestParseReductionSchemeErrors(t *testing.T) {
+	for _, spec := range []string{"unknown", "HG:H,G:E", "HGI:HH", ":H", "HGI", "HGI:H,EB:E,*:L", "HGI:H,S:S,*:C"} {
+		if _, err := ParseReductionScheme(spec); err == nil {
+			t.Errorf("Expected an error for %q", spec)
+		}
+	}
+}
+
+func TestReduceDataset(t *testing.T) {
+	scheme, _ := ParseReductionScheme("hec")
+	proteins := []LabeledProtein{{Name: "p1", Sequence: "AAAA", Labels: "GGTE"}}
+	reduced := ReduceDataset(proteins, scheme)
+	if reduced[0].Labels != "HHCE" || proteins[0].Labels != "GGTE" {
+		t.Errorf("Expected reduced labels HHCE and an unchanged input, got %s and %s", reduced[0].Labels, proteins[0].Labels)
+	}
+}
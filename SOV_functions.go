@@ -0,0 +1,104 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"math"
+)
+
+// labelSegment is a maximal run of one label in a label string, from Start to End inclusive
+type labelSegment struct {
+	Label      byte
+	Start, End int
+}
+
+// Length returns the number of residues in the segment
+func (s labelSegment) Length() int {
+	return s.End - s.Start + 1
+}
+
+// labelSegments()
+// Input: a label string
+// Output: the maximal runs of identical labels, in sequence order
+func labelSegments(labels string) []labelSegment {
+	var segments []labelSegment
+	for i := 0; i < len(labels); i++ {
+		if i > 0 && labels[i] == labels[i-1] {
+			segments[len(segments)-1].End = i
+			continue
+		}
+		segments = append(segments, labelSegment{Label: labels[i], Start: i, End: i})
+	}
+	return segments
+}
+
+// SOVScores()
+// Input: a predicted label string, the true (e.g. DSSP) label string of the same length, and the classes to score
+// Output: the SOV'99 sums and normalizations of each class (empty counts for a length mismatch)
+//
+// Segment overlap (Zemla et al., 1999) compares segments rather than residues. For class i,
+//
+//	SOV(i) = 100 * 1/N(i) * sum over overlapping pairs (s1, s2) of (minov + delta) / maxov * len(s1)
+//
+// where s1 is an observed segment and s2 a predicted segment of class i that overlap, minov is the length
+// of their overlap, maxov the length of their union and delta = min(maxov - minov, minov, len(s1)/2, len(s2)/2)
+// allows for small shifts at segment ends. N(i) adds len(s1) once per overlapping pair and once for every
+// observed segment that no predicted segment overlaps. The overall SOV sums both parts over all classes.
+func SOVScores(predicted, actual string, classes []string) map[string]SOVCounts {
+	counts := make(map[string]SOVCounts)
+	for _, class := range classes {
+		counts[class] = SOVCounts{}
+	}
+	if len(predicted) != len(actual) {
+		return counts
+	}
+
+	predictedSegments := labelSegments(predicted)
+	for _, s1 := range labelSegments(actual) {
+		class := string(s1.Label)
+		total, ok := counts[class]
+		if !ok {
+			continue // Not a scored class
+		}
+
+		overlapped := false
+		for _, s2 := range predictedSegments {
+			if s2.Label != s1.Label || s2.End < s1.Start || s2.Start > s1.End {
+				continue
+			}
+			overlapped = true
+			minov := Min(s1.End, s2.End) - Max(s1.Start, s2.Start) + 1
+			maxov := Max(s1.End, s2.End) - Min(s1.Start, s2.Start) + 1
+			delta := Min(Min(maxov-minov, minov), Min(s1.Length()/2, s2.Length()/2))
+			total.Sum += float64(minov+delta) / float64(maxov) * float64(s1.Length())
+			total.Length += s1.Length()
+		}
+		if !overlapped {
+			total.Length += s1.Length() // Missed segments only add to the normalization
+		}
+		counts[class] = total
+	}
+	return counts
+}
+
+// Score returns the SOV as a percentage, or NaN if the class has no observed residues
+func (c SOVCounts) Score() float64 {
+	if c.Length == 0 {
+		return math.NaN()
+	}
+	return 100.0 * c.Sum / float64(c.Length)
+}
+
+// OverallSOV()
+// Input: the SOV counts of every class
+// Output: the SOV over all classes, or NaN if no class was observed
+func OverallSOV(counts map[string]SOVCounts) float64 {
+	var total SOVCounts
+	for _, c := range counts {
+		total.Sum += c.Sum
+		total.Length += c.Length
+	}
+	return total.Score()
+}
@@ -0,0 +1,84 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestLabelSegments(t *testing.T) {
+	expected := []labelSegment{{'H', 0, 2}, {'C', 3, 3}, {'E', 4, 5}}
+	if result := labelSegments("HHHCEE"); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+	if result := labelSegments(""); len(result) != 0 {
+		t.Errorf("Expected no segments but got %v", result)
+	}
+}
+
+func TestSOVScores(t *testing.T) {
+	tests := []struct {
+		name      string             // Name of the test case
+		predicted string             // Predicted labels
+		actual    string             // Observed labels
+		class     map[string]float64 // Expected SOV of each class (NaN when undefined)
+		overall   float64            // Expected SOV over all classes
+	}{
+		{
+			name:      "Identical",
+			predicted: "CHHHHCCEEEC",
+			actual:    "CHHHHCCEEEC",
+			class:     map[string]float64{"H": 100, "E": 100, "C": 100, "T": math.NaN()},
+			overall:   100,
+		},
+		{
+			// The helix is split in two: (4+2)/8*8 + (2+1)/8*8 = 9 over N = 8+8,
+			// while the coil segment matches exactly and the predicted coil inside the helix is ignored
+			name:      "Fragmented helix",
+			predicted: "HHHHCCHHCC",
+			actual:    "HHHHHHHHCC",
+			class:     map[string]float64{"H": 56.25, "C": 100, "E": math.NaN(), "T": math.NaN()},
+			overall:   100.0 * 11 / 18,
+		},
+		{
+			// A one-residue shift of a long segment is forgiven by delta
+			name:      "Shifted strand",
+			predicted: "CCEEEEEECC",
+			actual:    "CEEEEEECCC",
+			class:     map[string]float64{"E": 100},
+			overall:   math.NaN(),
+		},
+		{
+			// A missed strand adds to N without any overlap
+			name:      "Missed strand",
+			predicted: "CCCCCC",
+			actual:    "CEEECC",
+			class:     map[string]float64{"E": 0},
+			overall:   math.NaN(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counts := SOVScores(tt.predicted, tt.actual, StructureClasses)
+			for class, expected := range tt.class {
+				result := counts[class].Score()
+				if math.IsNaN(expected) != math.IsNaN(result) || (!math.IsNaN(expected) && !floatEquals(result, expected, 1e-9)) {
+					t.Errorf("Class %s: expected %v but got %v", class, expected, result)
+				}
+			}
+			if !math.IsNaN(tt.overall) && !floatEquals(OverallSOV(counts), tt.overall, 1e-9) {
+				t.Errorf("Overall: expected %v but got %v", tt.overall, OverallSOV(counts))
+			}
+		})
+	}
+}
+
+func TestSOVScoresLengthMismatch(t *testing.T) {
+	if result := OverallSOV(SOVScores("HHH", "HH", StructureClasses)); !math.IsNaN(result) {
+		t.Errorf("Expected NaN for mismatched lengths but got %v", result)
+	}
+}
@@ -0,0 +1,124 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxRequestBytes limits the size of a prediction request body
+const maxRequestBytes = 16 << 20
+
+// Timeouts of the HTTP server, so slow or stalled clients cannot hold connections open indefinitely
+const (
+	serverReadHeaderTimeout = 10 * time.Second
+	serverReadTimeout       = time.Minute
+	serverWriteTimeout      = 5 * time.Minute // Large FASTA requests take a while to predict
+	serverIdleTimeout       = 2 * time.Minute
+)
+
+// NewServer()
+// Input: the address to listen on and the Predictor used to answer requests
+// Output: an http.Server serving NewServerMux with read, write and idle timeouts set
+func NewServer(addr string, p *Predictor) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           NewServerMux(p),
+		ReadHeaderTimeout: serverReadHeaderTimeout,
+		ReadTimeout:       serverReadTimeout,
+		WriteTimeout:      serverWriteTimeout,
+		IdleTimeout:       serverIdleTimeout,
+	}
+}
+
+// NewServerMux()
+// Input: the Predictor used to answer requests
+// Output: an http.ServeMux with the following endpoints:
+//   - GET  /health  returns "ok" (HEAD is also accepted)
+//   - POST /predict takes a raw sequence or FASTA text as the body and returns the predictions.
+//     The optional "format" query parameter selects json (default), tsv or text output.
+func NewServerMux(p *Predictor) *http.ServeMux {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", http.MethodGet+", "+http.MethodHead)
+			http.Error(w, "use GET or HEAD", http.StatusMethodNotAllowed)
+			return
+		}
+		fmt.Fprintln(w, "ok")
+	})
+
+	mux.HandleFunc("/predict", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "use POST with a sequence or FASTA body", http.StatusMethodNotAllowed)
+			return
+		}
+
+		format := r.URL.Query().Get("format")
+		if format == "" {
+			format = FormatJSON
+		}
+		if format != FormatText && format != FormatJSON && format != FormatTSV {
+			http.Error(w, fmt.Sprintf("unknown format %q", format), http.StatusBadRequest)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to read request: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		records, err := parseRequestRecords(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		results := make([]PredictionResult, len(records))
+		for i, record := range records {
+			results[i] = p.Predict(record)
+		}
+
+		// Buffer the output so an encoding error can still be reported with a proper status
+		var out bytes.Buffer
+		if err := WriteResults(&out, format, results); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		switch format {
+		case FormatJSON:
+			w.Header().Set("Content-Type", "application/json")
+		case FormatTSV:
+			w.Header().Set("Content-Type", "text/tab-separated-values")
+		default:
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		}
+		w.Write(out.Bytes())
+	})
+
+	return mux
+}
+
+// parseRequestRecords()
+// Input: the body of a prediction request
+// Output: the FASTA records in the body, or a single record without identifier for a raw sequence
+func parseRequestRecords(body []byte) ([]FASTARecord, error) {
+	text := strings.TrimSpace(string(body))
+	if text == "" {
+		return nil, fmt.Errorf("empty request body: send a sequence or FASTA records")
+	}
+	if strings.HasPrefix(text, ">") {
+		return ReadFASTA(strings.NewReader(text))
+	}
+	return []FASTARecord{{Sequence: strings.Join(strings.Fields(text), "")}}, nil
+}
@@ -0,0 +1,85 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServerPredict(t *testing.T) {
+	predictor, err := NewPredictor("GOR_InfoVals", "")
+	if err != nil {
+		t.Fatalf("Failed to load predictor: %v", err)
+	}
+	mux := NewServerMux(predictor)
+
+	tests := []struct {
+		name       string // Name of the test case
+		method     string // HTTP method
+		target     string // Request URL
+		body       string // Request body
+		wantStatus int    // Expected status code
+		wantIDs    []string
+	}{
+		{name: "Raw sequence", method: http.MethodPost, target: "/predict", body: "AAAAAAGGG\n", wantStatus: http.StatusOK, wantIDs: []string{""}},
+		{name: "FASTA records", method: http.MethodPost, target: "/predict", body: ">p1\nAAAAAA\n>p2\nVVVVV\n", wantStatus: http.StatusOK, wantIDs: []string{"p1", "p2"}},
+		{name: "Empty body", method: http.MethodPost, target: "/predict", body: "", wantStatus: http.StatusBadRequest},
+		{name: "Unknown format", method: http.MethodPost, target: "/predict?format=xml", body: "AAA", wantStatus: http.StatusBadRequest},
+		{name: "Wrong method", method: http.MethodGet, target: "/predict", wantStatus: http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("Test %s failed. Expected status %d but got %d: %s", tt.name, tt.wantStatus, rec.Code, rec.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var results []PredictionResult
+			if err := json.Unmarshal(rec.Body.Bytes(), &results); err != nil {
+				t.Fatalf("Test %s failed. Invalid JSON: %v", tt.name, err)
+			}
+			if len(results) != len(tt.wantIDs) {
+				t.Fatalf("Test %s failed. Expected %d results but got %d", tt.name, len(tt.wantIDs), len(results))
+			}
+			for i, result := range results {
+				if result.ID != tt.wantIDs[i] || result.Error != "" || len(result.HMM) != len(result.Sequence) {
+					t.Errorf("Test %s failed. Unexpected result %+v", tt.name, result)
+				}
+			}
+		})
+	}
+}
+
+func TestServerHealth(t *testing.T) {
+	mux := NewServerMux(&Predictor{})
+
+	tests := []struct {
+		method     string // HTTP method
+		wantStatus int    // Expected status code
+	}{
+		{method: http.MethodGet, wantStatus: http.StatusOK},
+		{method: http.MethodHead, wantStatus: http.StatusOK},
+		{method: http.MethodPost, wantStatus: http.StatusMethodNotAllowed},
+		{method: http.MethodDelete, wantStatus: http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(tt.method, "/health", nil))
+		if rec.Code != tt.wantStatus {
+			t.Errorf("%s /health: expected status %d but got %d", tt.method, tt.wantStatus, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// backboneAtoms accumulates the backbone atoms of one residue while a structure file is read
+type backboneAtoms struct {
+	residue BackboneResidue
+	found   map[string]bool
+}
+
+// add stores a backbone atom; other atoms and repeated alternate locations are ignored
+func (b *backboneAtoms) add(atom string, position Vector3) {
+	if b.found[atom] {
+		return
+	}
+	switch atom {
+	case "N":
+		b.residue.N = position
+	case "CA":
+		b.residue.CA = position
+	case "C":
+		b.residue.C = position
+	case "O":
+		b.residue.O = position
+	default:
+		return
+	}
+	b.found[atom] = true
+}
+
+// complete reports whether all four backbone atoms were found
+func (b *backboneAtoms) complete() bool {
+	return b.found["N"] && b.found["CA"] && b.found["C"] && b.found["O"]
+}
+
+// backboneCollector groups atom records into residues, keeping those with a complete backbone
+type backboneCollector struct {
+	residues []BackboneResidue
+	current  *backboneAtoms
+	key      string
+}
+
+// atom adds one atom record; a change of chain, number or residue name starts a new residue
+func (c *backboneCollector) atom(chain, number, name, atom, altLoc string, position Vector3) {
+	if altLoc != "" && altLoc != "." && altLoc != "A" && altLoc != "1" {
+		return // Only the first alternate location is used
+	}
+	key := chain + "|" + number + "|" + name
+	if c.current == nil || key != c.key {
+		c.flush()
+		c.key = key
+		c.current = &backboneAtoms{
+			residue: BackboneResidue{Chain: chain, Number: number, Name: name, AminoAcid: residueCode(name)},
+			found:   make(map[string]bool),
+		}
+	}
+	c.current.add(atom, position)
+}
+
+// flush stores the current residue if its backbone is complete
+func (c *backboneCollector) flush() {
+	if c.current != nil && c.current.complete() {
+		c.residues = append(c.residues, c.current.residue)
+	}
+	c.current = nil
+}
+
+// ParsePDB()
+// Input: an io.Reader with a PDB file
+// Output: the residues of the first model that have N, CA, C and O atoms, in file order, or an error
+// Both ATOM and HETATM records are read, so modified residues such as MSE are kept.
+func ParsePDB(r io.Reader) ([]BackboneResidue, error) {
+	scanner := bufio.NewScanner(r)
+	var collector backboneCollector
+	lineNumber := 0
+	for scanner.Scan() {
+		line := scanner.Text()
+		lineNumber++
+		if strings.HasPrefix(line, "ENDMDL") {
+			break // Only the first model is read
+		}
+		if !strings.HasPrefix(line, "ATOM  ") && !strings.HasPrefix(line, "HETATM") {
+			continue
+		}
+		if len(line) < 54 {
+			return nil, fmt.Errorf("line %d: atom record is too short", lineNumber)
+		}
+
+		var position Vector3
+		for k := 0; k < 3; k++ {
+			value, err := strconv.ParseFloat(strings.TrimSpace(line[30+8*k:38+8*k]), 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid coordinate: %v", lineNumber, err)
+			}
+			position[k] = value
+		}
+		collector.atom(
+			strings.TrimSpace(line[21:22]),
+			strings.TrimSpace(line[22:27]), // Residue number and insertion code
+			strings.TrimSpace(line[17:20]),
+			strings.TrimSpace(line[12:16]),
+			strings.TrimSpace(line[16:17]),
+			position,
+		)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	collector.flush()
+	if len(collector.residues) == 0 {
+		return nil, fmt.Errorf("no residues with a complete backbone found")
+	}
+	return collector.residues, nil
+}
+
+// ParseMMCIFStructure()
+// Input: an io.Reader with an mmCIF file
+// Output: the residues of the first model in _atom_site that have N, CA, C and O atoms, or an error
+// Author chain and residue numbers are used when present, as in PDB files.
+func ParseMMCIFStructure(r io.Reader) ([]BackboneResidue, error) {
+	tables, err := ParseCIF(r)
+	if err != nil {
+		return nil, err
+	}
+	return backboneFromAtomSite(tables)
+}
+
+// backboneFromAtomSite reads the backbone residues of the _atom_site table of a parsed mmCIF file
+func backboneFromAtomSite(tables map[string]*CIFTable) ([]BackboneResidue, error) {
+	table, ok := tables["_atom_site"]
+	if !ok {
+		return nil, fmt.Errorf("no _atom_site table found")
+	}
+
+	// Prefer author identifiers, falling back to the label ones
+	column := func(names ...string) int {
+		for _, name := range names {
+			if idx := table.Column(name); idx >= 0 {
+				return idx
+			}
+		}
+		return -1
+	}
+	atomColumn := column("label_atom_id", "auth_atom_id")
+	compColumn := column("label_comp_id", "auth_comp_id")
+	chainColumn := column("auth_asym_id", "label_asym_id")
+	seqColumn := column("auth_seq_id", "label_seq_id")
+	insColumn := column("pdbx_PDB_ins_code")
+	altColumn := column("label_alt_id")
+	modelColumn := column("pdbx_PDB_model_num")
+	xColumn, yColumn, zColumn := column("Cartn_x"), column("Cartn_y"), column("Cartn_z")
+	if atomColumn < 0 || compColumn < 0 || chainColumn < 0 || seqColumn < 0 || xColumn < 0 || yColumn < 0 || zColumn < 0 {
+		return nil, fmt.Errorf("_atom_site is missing atom, residue, chain or coordinate columns")
+	}
+
+	var collector backboneCollector
+	firstModel := ""
+	for _, row := range table.Rows {
+		if modelColumn >= 0 {
+			if firstModel == "" {
+				firstModel = row[modelColumn]
+			} else if row[modelColumn] != firstModel {
+				break // Only the first model is read
+			}
+		}
+
+		var position Vector3
+		for k, idx := range []int{xColumn, yColumn, zColumn} {
+			value, err := strconv.ParseFloat(row[idx], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid coordinate %q", row[idx])
+			}
+			position[k] = value
+		}
+		number := row[seqColumn]
+		if insColumn >= 0 && row[insColumn] != "?" && row[insColumn] != "." {
+			number += row[insColumn]
+		}
+		altLoc := ""
+		if altColumn >= 0 {
+			altLoc = row[altColumn]
+		}
+		collector.atom(row[chainColumn], number, row[compColumn], row[atomColumn], altLoc, position)
+	}
+	collector.flush()
+	if len(collector.residues) == 0 {
+		return nil, fmt.Errorf("no residues with a complete backbone found")
+	}
+	return collector.residues, nil
+}
+
+// ReadStructureFile()
+// Input: the path of a PDB or mmCIF coordinate file
+// Output: the backbone residues of the first model, or an error
+// mmCIF files are recognized by their data_ block; anything else is read as PDB.
+func ReadStructureFile(filename string) ([]BackboneResidue, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read structure file %s: %v", filename, err)
+	}
+
+	var residues []BackboneResidue
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte("data_")) {
+		residues, err = ParseMMCIFStructure(bytes.NewReader(content))
+	} else {
+		residues, err = ParsePDB(bytes.NewReader(content))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return residues, nil
+}
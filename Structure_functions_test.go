@@ -0,0 +1,84 @@
+// Group 2: Ab Initio Secondary Structure Prediction of Proteins
+// Date: 12th December, 2024
+// Members: Arth Banka, Riti Bhatia, Sanchitha Kuthethoor, Sumeet Kothare
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+const atomSiteCIF = `data_TEST
+#
+loop_
+_atom_site.group_PDB
+_atom_site.id
+_atom_site.label_atom_id
+_atom_site.label_alt_id
+_atom_site.label_comp_id
+_atom_site.label_asym_id
+_atom_site.label_seq_id
+_atom_site.pdbx_PDB_ins_code
+_atom_site.Cartn_x
+_atom_site.Cartn_y
+_atom_site.Cartn_z
+_atom_site.auth_seq_id
+_atom_site.auth_asym_id
+_atom_site.pdbx_PDB_model_num
+ATOM   1  N  . MET A 1 ? 0.0 0.0 0.0 10 B 1
+ATOM   2  CA . MET A 1 ? 1.5 0.0 0.0 10 B 1
+ATOM   3  C  . MET A 1 ? 2.0 1.4 0.0 10 B 1
+ATOM   4  O  . MET A 1 ? 1.3 2.4 0.0 10 B 1
+ATOM   5  CB . MET A 1 ? 2.0 -0.8 1.2 10 B 1
+ATOM   6  N  A LYS A 2 A 3.3 1.5 0.0 11 B 1
+ATOM   7  N  B LYS A 2 A 9.9 9.9 9.9 11 B 1
+ATOM   8  CA A LYS A 2 A 4.0 2.8 0.0 11 B 1
+ATOM   9  C  A LYS A 2 A 5.5 2.6 0.0 11 B 1
+ATOM   10 O  A LYS A 2 A 6.0 1.5 0.0 11 B 1
+ATOM   11 N  . GLY A 3 ? 6.2 3.7 0.0 12 B 1
+ATOM   12 CA . GLY A 3 ? 7.6 3.7 0.0 12 B 1
+ATOM   13 N  . MET A 1 ? 0.0 0.0 0.0 10 B 2
+#
+`
+
+func TestParseMMCIFStructure(t *testing.T) {
+	residues, err := ParseMMCIFStructure(strings.NewReader(atomSiteCIF))
+	if err != nil {
+		t.Fatalf("ParseMMCIFStructure returned error: %v", err)
+	}
+
+	// GLY lacks C and O, and the second model is ignored
+	if len(residues) != 2 {
+		t.Fatalf("Expected 2 residues, got %d", len(residues))
+	}
+	if residues[0].Chain != "B" || residues[0].Number != "10" || residues[0].AminoAcid != 'M' {
+		t.Errorf("Unexpected first residue %+v", residues[0])
+	}
+	if residues[1].Number != "11A" || residues[1].AminoAcid != 'K' {
+		t.Errorf("Unexpected second residue %+v", residues[1])
+	}
+	if residues[1].N != (Vector3{3.3, 1.5, 0}) {
+		t.Errorf("Expected the first alternate location for N, got %v", residues[1].N)
+	}
+}
+
+func TestParsePDBErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		message string
+	}{
+		{"no atoms", "HEADER    TEST\nEND\n", "no residues with a complete backbone"},
+		{"short record", "ATOM      1  N   ALA A   1       0.000\n", "line 1: atom record is too short"},
+		{"bad coordinate", "ATOM      1  N   ALA A   1         abc   0.000   0.000  1.00  0.00\n", "line 1: invalid coordinate"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParsePDB(strings.NewReader(test.content))
+			if err == nil || !strings.Contains(err.Error(), test.message) {
+				t.Errorf("Expected error containing %q, got %v", test.message, err)
+			}
+		})
+	}
+}
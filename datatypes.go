@@ -21,6 +21,20 @@ type BendProbabilities struct {
 	p1, p2, p3, p4 float64
 }
 
+// CFParameters holds the Chou-Fasman propensities and bend probabilities a prediction uses
+type CFParameters struct {
+	Propensities map[rune]AminoAcidPropensities // Pa, Pb and Pt of each amino acid
+	Bends        map[rune]BendProbabilities     // Bend probabilities f(i)..f(i+3) of each amino acid
+}
+
+// CFParameterSet is a named set of Chou-Fasman propensities and bend probabilities built into the binary
+type CFParameterSet struct {
+	Name         string                         // Name selected with -cf-set
+	Reference    string                         // Source of the values: the paper, table and page of a published set
+	Propensities map[rune]AminoAcidPropensities // Pa, Pb and Pt of each amino acid
+	Bends        map[rune]BendProbabilities     // Bend probabilities f(i)..f(i+3) of each amino acid
+}
+
 // Region represents a section of a protein sequence with a specific secondary structure
 type Region struct {
 	start     int     // Starting index of the region
@@ -85,16 +99,81 @@ var bendProbabilitiesTable = map[rune]BendProbabilities{
 // Following type is a map that contains the information values for a given structure.
 type InfoValTable map[string][]float64
 
+// InfoPairTable holds the GOR III information values of one structure: table[central][neighbor] is the row of
+// values of the neighbor residue at each window offset, given the type of the central residue
+type InfoPairTable map[string]map[string][]float64
+
 // GORPredictionResult holds the scores and predicted structure for a residue
 type GORPredictionResult struct {
-	Position int    // Position of the residue in the sequence
-	Residue  string // The amino acid at that position
+	Position int    `json:"position"` // Position of the residue in the sequence
+	Residue  string `json:"residue"`  // The amino acid at that position
 	// Total scores (tallies) per structure
-	ScoreAlpha         float64
-	ScoreBeta          float64
-	ScoreTurn          float64
-	ScoreCoil          float64
-	PredictedStructure string // Predicted structure string
+	ScoreAlpha         float64 `json:"score_alpha"`
+	ScoreBeta          float64 `json:"score_beta"`
+	ScoreTurn          float64 `json:"score_turn"`
+	ScoreCoil          float64 `json:"score_coil"`
+	PredictedStructure string  `json:"predicted_structure"` // Predicted structure string
+	// Class probabilities (softmax of the scores, see SetGORProbabilities)
+	ProbAlpha   float64 `json:"prob_alpha"`
+	ProbBeta    float64 `json:"prob_beta"`
+	ProbTurn    float64 `json:"prob_turn"`
+	ProbCoil    float64 `json:"prob_coil"`
+	Reliability int     `json:"reliability"` // Reliability index, 0 (low) to 9 (high)
+}
+
+// GORDecisionConstants holds the constant (in centinats) added to the GOR score of each structure before the argmax
+type GORDecisionConstants struct {
+	Alpha float64 `json:"alpha"`
+	Beta  float64 `json:"beta"`
+	Turn  float64 `json:"turn"`
+	Coil  float64 `json:"coil"`
+}
+
+// GORContribution holds what one window offset adds to each GOR score of an explained residue
+type GORContribution struct {
+	Offset   int     `json:"offset"`   // Offset from the explained residue
+	Position int     `json:"position"` // Position of the residue at the offset, 0 beyond either terminus
+	Residue  string  `json:"residue"`  // Residue at the offset, "-" beyond either terminus
+	Alpha    float64 `json:"alpha"`
+	Beta     float64 `json:"beta"`
+	Turn     float64 `json:"turn"`
+	Coil     float64 `json:"coil"`
+}
+
+// GORExplanation breaks the GOR scores of one residue down into the values that are summed to give them
+type GORExplanation struct {
+	ID            string               `json:"id,omitempty"`       // FASTA identifier of the record
+	Position      int                  `json:"position"`           // Position of the explained residue
+	Residue       string               `json:"residue"`            // The amino acid at that position
+	Method        string               `json:"method"`             // gor1, or gor3 with pair tables
+	Edge          string               `json:"edge"`               // Edge mode (see GOREdgeModes)
+	Contributions []GORContribution    `json:"contributions"`      // One per window offset, from -h to +h
+	Scale         float64              `json:"scale"`              // Factor applied to the summed contributions (normalize edge mode)
+	Constants     GORDecisionConstants `json:"decision_constants"` // Added after scaling
+	// Final scores: Scale times the summed contributions plus the decision constants
+	ScoreAlpha         float64 `json:"score_alpha"`
+	ScoreBeta          float64 `json:"score_beta"`
+	ScoreTurn          float64 `json:"score_turn"`
+	ScoreCoil          float64 `json:"score_coil"`
+	PredictedStructure string  `json:"predicted_structure"`
+	RunnerUp           string  `json:"runner_up"` // Structure with the second highest score
+	Margin             float64 `json:"margin"`    // Score of the predicted structure minus that of the runner-up
+}
+
+// GORCalibration holds the softmax temperature fitted by CalibrateGOR and the accuracy of each reliability index
+type GORCalibration struct {
+	Temperature    float64 // Fitted temperature in centinats
+	LogLoss        float64 // Mean log loss at the fitted temperature
+	DefaultLogLoss float64 // Mean log loss at DefaultGORTemperature
+	Residues       int     // Number of residues the temperature was fitted on
+	Reliability    [10]ReliabilityBin
+}
+
+// ReliabilityBin counts the residues with one value of the GOR reliability index
+type ReliabilityBin struct {
+	Residues        int     // Residues with this reliability index
+	Correct         int     // Residues whose predicted class matches the label
+	MeanProbability float64 // Mean probability of the predicted class
 }
 
 // GORPropensities represents context-based propensities for secondary structures
@@ -121,13 +200,239 @@ var GORModel = GORPropensities{
 	},
 }
 
+// SupervisedOptions holds the pseudocounts added to every initial, transition and emission count by
+// TrainSupervised. Equal values act as a symmetric Dirichlet prior; 1 is Laplace (add-one) smoothing.
+type SupervisedOptions struct {
+	InitialPseudocount    float64 // Added to the count of each starting state
+	TransitionPseudocount float64 // Added to the count of each state-to-state transition
+	EmissionPseudocount   float64 // Added to the count of each residue in each state
+}
+
+// BaumWelchOptions controls unsupervised Baum-Welch training of an HMM
+type BaumWelchOptions struct {
+	MaxIterations int                                        // Upper limit on the number of re-estimation iterations
+	Tolerance     float64                                    // Stop when the log-likelihood improves by less than this
+	Pseudocount   float64                                    // Added to every expected count before normalizing
+	Progress      func(iteration int, logLikelihood float64) // Called after each iteration if not nil
+}
+
+// BaumWelchResult reports the progress of Baum-Welch training
+type BaumWelchResult struct {
+	Iterations     int       // Number of iterations performed
+	LogLikelihoods []float64 // Total log-likelihood of the training sequences at the start of each iteration
+	Converged      bool      // Whether training stopped because the tolerance was reached
+}
+
+// AminoAcidSymbols lists the one-letter codes of the 20 standard amino acids, used as HMM observation symbols
+var AminoAcidSymbols = []string{"A", "C", "D", "E", "F", "G", "H", "I", "K", "L", "M", "N", "P", "Q", "R", "S", "T", "V", "W", "Y"}
+
 // HMM represents a Hidden Markov Model.
+// All probabilities are stored as natural logarithms; use LoadProbabilities to set them from probabilities.
 type HMM struct {
 	States        []string       // List of possible states (e.g., Helix, Sheet, Turn, Coil)
 	Symbols       []string       // List of possible observation symbols (e.g., amino acids)
-	Transition    [][]float64    // Transition probabilities between states
-	Emission      [][]float64    // Emission probabilities for symbols given a state
-	Initial       []float64      // Initial probabilities of each state
+	Transition    [][]float64    // Log transition probabilities between states
+	Emission      [][]float64    // Log emission probabilities for symbols given a state
+	Initial       []float64      // Log initial probabilities of each state
 	StateMapping  map[string]int // Maps state names to indices for easier lookup
 	SymbolMapping map[string]int // Maps symbol names to indices for easier lookup
 }
+
+// FASTARecord represents a single entry of a FASTA file
+type FASTARecord struct {
+	ID          string // Identifier: the first word of the header line, without the '>'
+	Description string // Remainder of the header line after the identifier
+	Sequence    string // Residues of the record with all whitespace removed
+}
+
+// Predictor bundles the parameters of the three prediction methods so they are loaded once per run
+type Predictor struct {
+	AlphaParams InfoValTable // GOR information values for alpha helix
+	BetaParams  InfoValTable // GOR information values for beta strand
+	TurnParams  InfoValTable // GOR information values for turn
+	CoilParams  InfoValTable // GOR information values for coil
+	HMM         *HMM         // HMM used for Viterbi decoding
+
+	// GOR III pair information values; when set, GOR predicts with these instead of the tables above
+	AlphaPairParams InfoPairTable
+	BetaPairParams  InfoPairTable
+	TurnPairParams  InfoPairTable
+	CoilPairParams  InfoPairTable
+
+	GORConstants   GORDecisionConstants // Decision constants added to the GOR scores; zero for none
+	GORTemperature float64              // Softmax temperature of the GOR probabilities; 0 for DefaultGORTemperature
+	GOREdge        string               // How GOR scores window positions beyond the termini (GOREdgeModes); "" for skip
+
+	CF        CFParameters // Chou-Fasman propensities and bend probabilities; the built-in tables if unset
+	CFOverlap string       // How Chou-Fasman resolves overlapping regions (CFOverlapModes); "" for score
+}
+
+// PredictionResult holds the output of all three methods for one input record
+type PredictionResult struct {
+	ID            string                `json:"id,omitempty"`             // FASTA identifier of the record
+	Description   string                `json:"description,omitempty"`    // FASTA description of the record
+	Sequence      string                `json:"sequence"`                 // Upper-cased input sequence
+	ChouFasman    string                `json:"chou_fasman,omitempty"`    // Chou-Fasman label string
+	GOR           string                `json:"gor,omitempty"`            // GOR label string
+	HMM           string                `json:"hmm,omitempty"`            // HMM (Viterbi) label string
+	GORScores     []GORPredictionResult `json:"gor_scores,omitempty"`     // Per-residue GOR information scores
+	HMMStates     []string              `json:"hmm_states,omitempty"`     // HMM state names, in the order used by HMMPosteriors
+	HMMPosteriors [][]float64           `json:"hmm_posteriors,omitempty"` // Per-residue posterior probability of each HMM state
+	AlignmentRows int                   `json:"alignment_rows,omitempty"` // Rows of the alignment GOR averaged over, if any
+	Error         string                `json:"error,omitempty"`          // Reason the record could not be predicted
+}
+
+// LabeledProtein is a protein sequence paired with its per-residue secondary structure labels (e.g. from DSSP)
+type LabeledProtein struct {
+	Name     string `json:"name"`     // Protein identifier
+	Sequence string `json:"sequence"` // Amino acid sequence
+	Labels   string `json:"labels"`   // Secondary structure label string, one character per residue
+}
+
+// CIFTable holds one category of a CIF/mmCIF file, e.g. the rows of _atom_site
+type CIFTable struct {
+	Columns []string   // Item names without the category prefix, e.g. "label_asym_id"
+	Rows    [][]string // One value per column; "." and "?" mark missing values
+}
+
+// DSSPResidue is one residue of a DSSP secondary structure assignment
+type DSSPResidue struct {
+	Chain     string // Chain identifier
+	Number    string // Residue number (with insertion code, if any) as written in the file
+	AminoAcid byte   // One-letter amino acid code; X for a residue that is not one of the 20 standard amino acids
+	Structure byte   // DSSP code (H, G, I, E, B, T, S, P), or - for no assigned structure
+	Break     bool   // True if a chain break precedes this residue
+}
+
+// Vector3 is a point or direction in Cartesian space, in Angstrom
+type Vector3 [3]float64
+
+// BackboneResidue holds the backbone atoms of one amino acid read from a PDB or mmCIF file
+type BackboneResidue struct {
+	Chain     string  // Chain identifier (author chain for mmCIF)
+	Number    string  // Residue number with insertion code, as written in the file
+	Name      string  // Three-letter residue name
+	AminoAcid byte    // One-letter code, X for an unknown residue
+	N         Vector3 // Backbone amide nitrogen
+	CA        Vector3 // Alpha carbon
+	C         Vector3 // Carbonyl carbon
+	O         Vector3 // Carbonyl oxygen
+}
+
+// AssignedStructure holds the secondary structure assigned to the residues of one coordinate file
+type AssignedStructure struct {
+	Name     string        // File name without extension
+	Residues []DSSPResidue // Residues of the selected chains, in file order
+}
+
+// ReductionScheme maps DSSP secondary structure codes (H, G, I, E, B, T, S, P, C, - ...) onto the smaller
+// set of classes that the predictors are trained and scored on
+type ReductionScheme struct {
+	Name        string        // Name used to select the scheme, e.g. on the command line
+	Description string        // One-line summary of the mapping
+	Classes     []string      // Output classes, in the order used for HMM states
+	Mapping     map[byte]byte // DSSP code to class; codes not listed map to Default
+	Default     byte          // Class of every code not in Mapping
+}
+
+// ProteinEvaluation holds the scores of each prediction method on one labeled protein
+type ProteinEvaluation struct {
+	Name   string                  // Protein identifier
+	Length int                     // Number of residues
+	Scores map[string]MethodScores // Residue counts of each method, keyed by method name
+	Error  string                  // Reason the protein was not evaluated
+}
+
+// ClassCounts holds the residue counts behind the precision and recall of one structure class
+type ClassCounts struct {
+	TruePositives  int // Residues of the class predicted as the class
+	FalsePositives int // Residues of another class predicted as the class
+	FalseNegatives int // Residues of the class predicted as another class
+}
+
+// MethodScores holds the residue counts of one prediction method on one protein, or summed over a dataset
+type MethodScores struct {
+	Residues  int                       // Number of residues scored
+	CorrectQ3 int                       // Residues predicted correctly after reducing both strings to H/E/C
+	CorrectQ4 int                       // Residues predicted correctly over H/E/T/C
+	Classes   map[string]ClassCounts    // Counts of each structure class, keyed by label
+	SOV       map[string]SOVCounts      // Segment overlap sums of each structure class, keyed by label
+	Confusion map[string]map[string]int // Residue counts keyed by true label, then predicted label
+}
+
+// SOVCounts holds the two sums behind the SOV'99 score of one structure class
+type SOVCounts struct {
+	Sum    float64 // Sum of (minov + delta) / maxov * len(s1) over overlapping segment pairs
+	Length int     // Normalization N: observed segment lengths, once per overlapping pair or missed segment
+}
+
+// EvaluationReport is the machine-readable form of an evaluation
+type EvaluationReport struct {
+	Proteins []ProteinReport         `json:"proteins"` // One entry per protein in the dataset
+	Overall  map[string]MethodReport `json:"overall"`  // Scores over all evaluated residues, keyed by method name
+}
+
+// ProteinReport holds the scores of every method on one protein
+type ProteinReport struct {
+	Name    string                  `json:"name"`
+	Length  int                     `json:"length"`
+	Methods map[string]MethodReport `json:"methods,omitempty"` // Keyed by method name
+	Error   string                  `json:"error,omitempty"`   // Reason the protein was not evaluated
+}
+
+// MethodReport holds the accuracy, segment overlap and per-class metrics (as percentages) and the confusion
+// matrix of one method
+type MethodReport struct {
+	Q3        float64                   `json:"q3"`
+	Q4        float64                   `json:"q4"`
+	SOV       *float64                  `json:"sov"`       // SOV'99 over all classes
+	Classes   map[string]ClassReport    `json:"classes"`   // Keyed by structure class label
+	Confusion map[string]map[string]int `json:"confusion"` // Residue counts keyed by true label, then predicted label
+}
+
+// ClassReport holds the precision, recall, F1 score and SOV of one structure class, as percentages, and its MCC.
+// A metric is null when it is undefined, e.g. the precision of a class that was never predicted.
+type ClassReport struct {
+	Precision *float64 `json:"precision"`
+	Recall    *float64 `json:"recall"`
+	F1        *float64 `json:"f1"`
+	SOV       *float64 `json:"sov"`
+	MCC       *float64 `json:"mcc"` // Matthews correlation coefficient, from -1 to 1 (not a percentage)
+}
+
+// HMMProvenance records how an HMM model file was produced
+type HMMProvenance struct {
+	CreatedAt     string  `json:"created_at,omitempty"`     // Creation time in RFC 3339 format
+	Method        string  `json:"method,omitempty"`         // Training method, e.g. "supervised" or "supervised+baum-welch"
+	TrainingData  string  `json:"training_data,omitempty"`  // Labeled dataset used for supervised training
+	UnlabeledData string  `json:"unlabeled_data,omitempty"` // FASTA file used for Baum-Welch refinement
+	Sequences     int     `json:"sequences,omitempty"`      // Number of labeled training sequences
+	Iterations    int     `json:"iterations,omitempty"`     // Number of Baum-Welch iterations
+	LogLikelihood float64 `json:"log_likelihood,omitempty"` // Final Baum-Welch log-likelihood
+	Notes         string  `json:"notes,omitempty"`          // Free-form description
+}
+
+// ParameterConfig names external parameter files that replace the built-in ones; empty fields keep the defaults
+type ParameterConfig struct {
+	GORDir    string `json:"gor_dir,omitempty"`    // Directory of GOR InfoVal_*.csv tables
+	GOR3Dir   string `json:"gor3_dir,omitempty"`   // Directory of GOR III InfoPair_*.csv tables
+	HMMModel  string `json:"hmm_model,omitempty"`  // HMM model file written by 'train -out'
+	CFParams  string `json:"cf_params,omitempty"`  // Chou-Fasman parameter file
+	CFSet     string `json:"cf_set,omitempty"`     // Built-in Chou-Fasman parameter set (see CFParameterSets)
+	CFOverlap string `json:"cf_overlap,omitempty"` // Chou-Fasman overlap mode: score or average
+
+	GORConstants   string  `json:"gor_constants,omitempty"`   // GOR decision constants file written by 'gor-train -fit-constants'
+	GORTemperature float64 `json:"gor_temperature,omitempty"` // GOR softmax temperature fitted by 'gor-calibrate'
+	GOREdge        string  `json:"gor_edge,omitempty"`        // GOR edge mode: skip, normalize or terminus
+}
+
+// HMMModelFile is the on-disk JSON representation of an HMM, with parameters stored as probabilities
+type HMMModelFile struct {
+	FormatVersion int           `json:"format_version"` // Version of the file layout (HMMModelFormatVersion)
+	States        []string      `json:"states"`         // State names
+	Symbols       []string      `json:"symbols"`        // Observation symbols
+	Initial       []float64     `json:"initial"`        // Initial probability of each state
+	Transition    [][]float64   `json:"transition"`     // Transition probabilities, one row per state
+	Emission      [][]float64   `json:"emission"`       // Emission probabilities, one row per state
+	Provenance    HMMProvenance `json:"provenance"`     // How the model was produced
+}
@@ -4,7 +4,9 @@
 package main
 
 import (
+	"math"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -48,18 +50,21 @@ func TestViterbi(t *testing.T) {
 		[]string{"A", "C", "G", "T"},
 	)
 
-	hmm.Transition = [][]float64{
-		{0.6, 0.3, 0.1},
-		{0.1, 0.7, 0.2},
-		{0.3, 0.3, 0.4},
-	}
-	hmm.Emission = [][]float64{
-		{0.2, 0.4, 0.3, 0.1},
-		{0.1, 0.3, 0.4, 0.2},
-		{0.4, 0.1, 0.2, 0.3},
-	}
-	hmm.Initial = []float64{0.5, 0.3, 0.2}
+	hmm.LoadProbabilities(
+		[]float64{0.5, 0.3, 0.2},
+		[][]float64{
+			{0.6, 0.3, 0.1},
+			{0.1, 0.7, 0.2},
+			{0.3, 0.3, 0.4},
+		},
+		[][]float64{
+			{0.2, 0.4, 0.3, 0.1},
+			{0.1, 0.3, 0.4, 0.2},
+			{0.4, 0.1, 0.2, 0.3},
+		},
+	)
 
+	// Expected paths are the maximum-likelihood state sequences (see TestViterbiMatchesExhaustiveSearch)
 	tests := []struct {
 		name         string
 		sequence     string
@@ -73,26 +78,356 @@ func TestViterbi(t *testing.T) {
 		{
 			name:         "Repeated observation sequence",
 			sequence:     "AAA",
-			expectedPath: "HHH",
+			expectedPath: "CCC",
 		},
 		{
 			name:         "Mixed observation sequence",
 			sequence:     "GTA",
-			expectedPath: "SSS",
+			expectedPath: "SSC",
 		},
 		{
 			name:         "Complex observation sequence",
 			sequence:     "TCGA",
-			expectedPath: "SSSS",
+			expectedPath: "SSSC",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resultPath := hmm.Viterbi(tt.sequence)
+			resultPath, err := hmm.Viterbi(tt.sequence)
+			if err != nil {
+				t.Fatalf("%s: Viterbi returned error: %v", tt.name, err)
+			}
 			if resultPath != tt.expectedPath {
 				t.Errorf("%s: got %v, expected %v", tt.name, resultPath, tt.expectedPath)
 			}
 		})
 	}
 }
+
+// bestPathByEnumeration returns the maximum-likelihood state path by scoring every possible path
+func bestPathByEnumeration(hmm *HMM, sequence string) string {
+	T, N := len(sequence), len(hmm.States)
+	path := make([]int, T)
+	best, bestScore := "", math.Inf(-1)
+	var visit func(t int)
+	visit = func(t int) {
+		if t == T {
+			score := hmm.Initial[path[0]] + hmm.Emission[path[0]][hmm.SymbolMapping[string(sequence[0])]]
+			for k := 1; k < T; k++ {
+				score += hmm.Transition[path[k-1]][path[k]] + hmm.Emission[path[k]][hmm.SymbolMapping[string(sequence[k])]]
+			}
+			if score > bestScore {
+				bestScore = score
+				best = ""
+				for _, s := range path {
+					best += string(hmm.States[s][0])
+				}
+			}
+			return
+		}
+		for s := 0; s < N; s++ {
+			path[t] = s
+			visit(t + 1)
+		}
+	}
+	visit(0)
+	return best
+}
+
+func TestViterbiMatchesExhaustiveSearch(t *testing.T) {
+	hmm := NewHMM([]string{"Helix", "ESheet", "Coil"}, []string{"A", "C", "G", "T"})
+	hmm.LoadProbabilities(
+		[]float64{0.2, 0.5, 0.3},
+		[][]float64{
+			{0.8, 0.1, 0.1},
+			{0.2, 0.5, 0.3},
+			{0.25, 0.25, 0.5},
+		},
+		[][]float64{
+			{0.5, 0.2, 0.2, 0.1},
+			{0.1, 0.1, 0.4, 0.4},
+			{0.25, 0.25, 0.25, 0.25},
+		},
+	)
+
+	for _, sequence := range []string{"A", "GT", "AACGT", "TTTTAAAA", "ACGTACGTA", "GGGCAAAT"} {
+		expected := bestPathByEnumeration(hmm, sequence)
+		if result, err := hmm.Viterbi(sequence); err != nil || result != expected {
+			t.Errorf("Sequence %s: got %s (error %v), expected %s", sequence, result, err, expected)
+		}
+	}
+
+	// A symbol the HMM does not emit is an error rather than a crash
+	if _, err := hmm.Viterbi("ACXT"); err == nil || !strings.Contains(err.Error(), "invalid symbol X at position 3") {
+		t.Errorf("Expected an invalid symbol error, got %v", err)
+	}
+}
+
+func TestLoadProbabilities(t *testing.T) {
+	hmm := NewHMM([]string{"Helix", "Coil"}, []string{"A", "G"})
+	initial := []float64{0.25, 0.75}
+	transition := [][]float64{{0.9, 0.1}, {0.0, 1.0}}
+	emission := [][]float64{{0.5, 0.5}, {0.2, 0.8}}
+	hmm.LoadProbabilities(initial, transition, emission)
+
+	if !floatEquals(hmm.Initial[0], math.Log(0.25), 1e-12) || !math.IsInf(hmm.Transition[1][0], -1) {
+		t.Errorf("Parameters were not converted to log space: %v %v", hmm.Initial, hmm.Transition)
+	}
+
+	// Converting back must give the original probabilities
+	gotInitial, gotTransition, gotEmission := hmm.Probabilities()
+	if !floatEquals(gotTransition[0][1], 0.1, 1e-12) || gotTransition[1][0] != 0 || !floatEquals(gotInitial[1], 0.75, 1e-12) || !floatEquals(gotEmission[1][1], 0.8, 1e-12) {
+		t.Errorf("Round trip failed: got %v %v %v", gotInitial, gotTransition, gotEmission)
+	}
+
+	// A zero transition probability makes Coil -> Helix impossible
+	if path, err := hmm.Viterbi("GA"); err != nil || path[0] == 'C' && path[1] == 'H' {
+		t.Errorf("Viterbi used an impossible transition: %s (error %v)", path, err)
+	}
+}
+
+// likelihoodByEnumeration returns P(sequence) by summing the probability of every state path
+func likelihoodByEnumeration(hmm *HMM, sequence string) float64 {
+	T, N := len(sequence), len(hmm.States)
+	path := make([]int, T)
+	total := 0.0
+	var visit func(t int)
+	visit = func(t int) {
+		if t == T {
+			score := hmm.Initial[path[0]] + hmm.Emission[path[0]][hmm.SymbolMapping[string(sequence[0])]]
+			for k := 1; k < T; k++ {
+				score += hmm.Transition[path[k-1]][path[k]] + hmm.Emission[path[k]][hmm.SymbolMapping[string(sequence[k])]]
+			}
+			total += math.Exp(score)
+			return
+		}
+		for s := 0; s < N; s++ {
+			path[t] = s
+			visit(t + 1)
+		}
+	}
+	visit(0)
+	return total
+}
+
+// testPosteriorHMM returns a small three-state HMM used by the forward-backward tests
+func testPosteriorHMM() *HMM {
+	hmm := NewHMM([]string{"Helix", "ESheet", "Coil"}, []string{"A", "C", "G", "T"})
+	hmm.LoadProbabilities(
+		[]float64{0.2, 0.5, 0.3},
+		[][]float64{
+			{0.8, 0.1, 0.1},
+			{0.2, 0.5, 0.3},
+			{0.25, 0.25, 0.5},
+		},
+		[][]float64{
+			{0.5, 0.2, 0.2, 0.1},
+			{0.1, 0.1, 0.4, 0.4},
+			{0.25, 0.25, 0.25, 0.25},
+		},
+	)
+	return hmm
+}
+
+func TestPosterior(t *testing.T) {
+	hmm := testPosteriorHMM()
+
+	for _, sequence := range []string{"A", "GT", "AACGT", "TTTTAAAA"} {
+		posteriors, logLikelihood, err := hmm.Posterior(sequence)
+		if err != nil {
+			t.Fatalf("Sequence %s: unexpected error %v", sequence, err)
+		}
+
+		// The scaled forward pass must give the same likelihood as summing over all paths
+		expected := math.Log(likelihoodByEnumeration(hmm, sequence))
+		if !floatEquals(logLikelihood, expected, 1e-9) {
+			t.Errorf("Sequence %s: log-likelihood %v, expected %v", sequence, logLikelihood, expected)
+		}
+
+		for pos, probs := range posteriors {
+			total := 0.0
+			for _, p := range probs {
+				if p < 0 || p > 1 {
+					t.Errorf("Sequence %s position %d: probability %v out of range", sequence, pos+1, p)
+				}
+				total += p
+			}
+			if !floatEquals(total, 1.0, 1e-9) {
+				t.Errorf("Sequence %s position %d: posteriors sum to %v", sequence, pos+1, total)
+			}
+		}
+	}
+
+	// The first position of "A" only depends on the initial and emission probabilities
+	posteriors, _, _ := hmm.Posterior("A")
+	norm := 0.2*0.5 + 0.5*0.1 + 0.3*0.25
+	if !floatEquals(posteriors[0][0], 0.2*0.5/norm, 1e-12) {
+		t.Errorf("Posterior of Helix for A: got %v, expected %v", posteriors[0][0], 0.2*0.5/norm)
+	}
+}
+
+func TestPosteriorLongSequence(t *testing.T) {
+	hmm := testPosteriorHMM()
+
+	// Unscaled probabilities underflow long before 5000 residues
+	sequence := strings.Repeat("ACGTTGCA", 625)
+	posteriors, logLikelihood, err := hmm.Posterior(sequence)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if math.IsInf(logLikelihood, 0) || math.IsNaN(logLikelihood) || logLikelihood >= 0 {
+		t.Errorf("Expected a finite negative log-likelihood, got %v", logLikelihood)
+	}
+	for pos, probs := range posteriors {
+		for _, p := range probs {
+			if math.IsNaN(p) {
+				t.Fatalf("Position %d: posterior is NaN", pos+1)
+			}
+		}
+	}
+}
+
+func TestPosteriorDecode(t *testing.T) {
+	hmm := testPosteriorHMM()
+
+	labels, posteriors, err := hmm.PosteriorDecode("AAAAGTGT")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(labels) != 8 || len(posteriors) != 8 {
+		t.Fatalf("Expected 8 labels and posteriors, got %q and %d", labels, len(posteriors))
+	}
+	if labels[:4] != "HHHH" {
+		t.Errorf("Expected the alanine run to be decoded as helix, got %s", labels)
+	}
+
+	if _, _, err := hmm.PosteriorDecode("AXA"); err == nil {
+		t.Errorf("Expected an error for an unknown symbol")
+	}
+}
+
+func TestBaumWelch(t *testing.T) {
+	hmm := testPosteriorHMM()
+	sequences := []string{"AAAAGTGTGTAAAA", "GTTGCAAAAAAC", "ACGTACGTAAAAAAAAGGTT", ""}
+
+	var reported []float64
+	result, err := hmm.BaumWelch(sequences, BaumWelchOptions{
+		MaxIterations: 200,
+		Tolerance:     1e-8,
+		Progress: func(iteration int, logLikelihood float64) {
+			reported = append(reported, logLikelihood)
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.Converged || result.Iterations != len(result.LogLikelihoods) || !reflect.DeepEqual(reported, result.LogLikelihoods) {
+		t.Errorf("Unexpected result: converged %v after %d iterations, %d log-likelihoods, %d reported",
+			result.Converged, result.Iterations, len(result.LogLikelihoods), len(reported))
+	}
+
+	// EM never decreases the likelihood
+	for i := 1; i < len(result.LogLikelihoods); i++ {
+		if result.LogLikelihoods[i] < result.LogLikelihoods[i-1]-1e-9 {
+			t.Errorf("Log-likelihood decreased at iteration %d: %v -> %v", i+1, result.LogLikelihoods[i-1], result.LogLikelihoods[i])
+		}
+	}
+
+	// The re-estimated parameters must still be probability distributions
+	initial, transition, emission := hmm.Probabilities()
+	rows := append([][]float64{initial}, append(transition, emission...)...)
+	for _, row := range rows {
+		total := 0.0
+		for _, p := range row {
+			total += p
+		}
+		if !floatEquals(total, 1.0, 1e-9) {
+			t.Errorf("Row %v sums to %v", row, total)
+		}
+	}
+}
+
+func TestBaumWelchStopsAtMaxIterations(t *testing.T) {
+	hmm := testPosteriorHMM()
+	result, err := hmm.BaumWelch([]string{"AAAAGTGTGTAAAA"}, BaumWelchOptions{MaxIterations: 3})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Iterations != 3 || len(result.LogLikelihoods) != 3 || result.Converged {
+		t.Errorf("Expected 3 iterations without convergence, got %+v", result)
+	}
+}
+
+func TestBaumWelchErrors(t *testing.T) {
+	hmm := testPosteriorHMM()
+	if _, err := hmm.BaumWelch([]string{"AAXA"}, BaumWelchOptions{MaxIterations: 5}); err == nil {
+		t.Errorf("Expected an error for an unknown symbol")
+	}
+	if _, err := hmm.BaumWelch([]string{""}, BaumWelchOptions{MaxIterations: 5}); err == nil {
+		t.Errorf("Expected an error when there are no sequences")
+	}
+	if _, err := hmm.BaumWelch([]string{"ACGT"}, BaumWelchOptions{}); err == nil {
+		t.Errorf("Expected an error for a zero iteration limit")
+	}
+}
+
+func TestTrainSupervised(t *testing.T) {
+	proteins := []LabeledProtein{
+		{Name: "p1", Sequence: "AAG", Labels: "HHE"},
+		{Name: "p2", Sequence: "GA", Labels: "EE"},
+	}
+
+	// Without pseudocounts the parameters are the observed frequencies
+	hmm := NewHMM1([]string{"H", "E"}, []string{"A", "G"})
+	if err := hmm.TrainSupervised(proteins, SupervisedOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	initial, transition, emission := hmm.Probabilities()
+	checkProbs := func(name string, got, want []float64) {
+		for i := range want {
+			if !floatEquals(got[i], want[i], 1e-12) {
+				t.Errorf("%s: expected %v but got %v", name, want, got)
+				return
+			}
+		}
+	}
+	checkProbs("initial", initial, []float64{0.5, 0.5})
+	checkProbs("transition H", transition[0], []float64{0.5, 0.5})
+	checkProbs("transition E", transition[1], []float64{0, 1})
+	checkProbs("emission H", emission[0], []float64{1, 0})
+	checkProbs("emission E", emission[1], []float64{1.0 / 3, 2.0 / 3})
+
+	// Laplace smoothing gives the unseen G in state H a non-zero probability
+	if err := hmm.TrainSupervised(proteins, SupervisedOptions{InitialPseudocount: 1, TransitionPseudocount: 1, EmissionPseudocount: 1}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_, transition, emission = hmm.Probabilities()
+	checkProbs("smoothed transition E", transition[1], []float64{1.0 / 3, 2.0 / 3})
+	checkProbs("smoothed emission H", emission[0], []float64{0.75, 0.25})
+}
+
+func TestTrainSupervisedErrors(t *testing.T) {
+	tests := []struct {
+		name     string           // Name of the test case
+		proteins []LabeledProtein // Training data
+		options  SupervisedOptions
+		wantErr  string // Substring expected in the error
+	}{
+		{name: "Length mismatch", proteins: []LabeledProtein{{Name: "p1", Sequence: "AAG", Labels: "HH"}}, wantErr: "p1: sequence has 3 residues but 2 labels"},
+		{name: "Unknown residue", proteins: []LabeledProtein{{Name: "p1", Sequence: "AXG", Labels: "HHH"}}, wantErr: "p1: invalid symbol X at position 2"},
+		{name: "Unknown label", proteins: []LabeledProtein{{Name: "p1", Sequence: "AAG", Labels: "HGH"}}, wantErr: "p1: unknown label G at position 2"},
+		{name: "No data", proteins: []LabeledProtein{{Name: "p1"}}, wantErr: "no non-empty labeled sequences"},
+		{name: "Negative pseudocount", proteins: []LabeledProtein{{Name: "p1", Sequence: "A", Labels: "H"}}, options: SupervisedOptions{EmissionPseudocount: -1}, wantErr: "must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hmm := NewHMM1([]string{"H", "E"}, []string{"A", "G"})
+			err := hmm.TrainSupervised(tt.proteins, tt.options)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected an error containing %q but got %v", tt.wantErr, err)
+			}
+		})
+	}
+}